@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// GetWorkflowTemplateRefSpec returns the spec of the workflow template or cluster workflow template the workflow spec
+// references, or nil if it does not reference one
+func GetWorkflowTemplateRefSpec(ctx context.Context, apiClient apiclient.Client, namespace string, wfSpec *wfv1.WorkflowSpec) (*wfv1.WorkflowSpec, error) {
+	ref := wfSpec.WorkflowTemplateRef
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.ClusterScope {
+		serviceClient, err := apiClient.NewClusterWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		cwftmpl, err := serviceClient.GetClusterWorkflowTemplate(ctx, &clusterworkflowtmplpkg.ClusterWorkflowTemplateGetRequest{
+			Name: ref.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cwftmpl.GetWorkflowSpec(), nil
+	}
+	serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	wftmpl, err := serviceClient.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{
+		Name:      ref.Name,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wftmpl.GetWorkflowSpec(), nil
+}
@@ -75,15 +75,19 @@ func CreateCronWorkflows(filePaths []string, cliOpts *cliCreateOpts, submitOpts
 			cronWf.Spec.Schedule = cliOpts.schedule
 		}
 
+		if cronWf.Namespace == "" {
+			cronWf.Namespace = client.Namespace()
+		}
 		newWf := wfv1.Workflow{Spec: cronWf.Spec.WorkflowSpec}
-		err := util.ApplySubmitOpts(&newWf, submitOpts)
+		wftmplSpec, err := client.GetWorkflowTemplateRefSpec(ctx, apiClient, cronWf.Namespace, &newWf.Spec)
 		if err != nil {
 			log.Fatal(err)
 		}
-		cronWf.Spec.WorkflowSpec = newWf.Spec
-		if cronWf.Namespace == "" {
-			cronWf.Namespace = client.Namespace()
+		err = util.ApplySubmitOpts(&newWf, wftmplSpec, submitOpts)
+		if err != nil {
+			log.Fatal(err)
 		}
+		cronWf.Spec.WorkflowSpec = newWf.Spec
 		created, err := serviceClient.CreateCronWorkflow(ctx, &cronworkflowpkg.CreateCronWorkflowRequest{
 			Namespace:    cronWf.Namespace,
 			CronWorkflow: &cronWf,
@@ -14,11 +14,12 @@ import (
 )
 
 type resubmitOps struct {
-	priority      int32  // --priority
-	memoized      bool   // --memoized
-	namespace     string // --namespace
-	labelSelector string // --selector
-	fieldSelector string // --field-selector
+	priority      int32    // --priority
+	memoized      bool     // --memoized
+	parameters    []string // --parameter
+	namespace     string   // --namespace
+	labelSelector string   // --selector
+	fieldSelector string   // --field-selector
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -53,6 +54,10 @@ func NewResubmitCommand() *cobra.Command {
 
   argo resubmit --field-selector metadata.namespace=argo
 
+# Resubmit a workflow with a different parameter value:
+
+  argo resubmit my-wf -p message="goodbye world"
+
 # Resubmit and wait for completion:
 
   argo resubmit --wait my-wf.yaml
@@ -88,6 +93,7 @@ func NewResubmitCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow until it completes, only works when a single workflow is resubmitted")
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&resubmitOpts.memoized, "memoized", false, "re-use successful steps & outputs from the previous run (experimental)")
+	command.Flags().StringArrayVarP(&resubmitOpts.parameters, "parameter", "p", []string{}, "override an input parameter of the previous run")
 	command.Flags().StringVarP(&resubmitOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&resubmitOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
@@ -130,9 +136,10 @@ func resubmitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowSe
 		resubmittedNames[wf.Name] = true
 
 		lastResubmitted, err = serviceClient.ResubmitWorkflow(ctx, &workflowpkg.WorkflowResubmitRequest{
-			Namespace: wf.Namespace,
-			Name:      wf.Name,
-			Memoized:  resubmitOpts.memoized,
+			Namespace:  wf.Namespace,
+			Name:       wf.Name,
+			Memoized:   resubmitOpts.memoized,
+			Parameters: resubmitOpts.parameters,
 		})
 		if err != nil {
 			return err
@@ -71,6 +71,10 @@ func NewRetryCommand() *cobra.Command {
 # Retry the latest workflow:
 
   argo retry @latest
+
+# Re-run a node of a completed workflow, along with every node that depends on it:
+
+  argo retry my-wf --restart-successful --node-field-selector name=my-wf.b
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 && !retryOpts.hasSelector() {
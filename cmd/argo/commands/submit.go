@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -48,6 +49,12 @@ func NewSubmitCommand() *cobra.Command {
 
   argo submit my-wf.yaml
 
+# Submit every workflow in a directory, from stdin, or from a URL:
+
+  argo submit my-wfs/
+  cat my-wf.yaml | argo submit -
+  argo submit https://raw.githubusercontent.com/argoproj/argo-workflows/master/examples/hello-world.yaml
+
 # Submit and wait for completion:
 
   argo submit --wait my-wf.yaml
@@ -83,7 +90,7 @@ func NewSubmitCommand() *cobra.Command {
 				}
 				submitWorkflowFromResource(ctx, serviceClient, namespace, from, &submitOpts, &cliSubmitOpts)
 			} else {
-				submitWorkflowsFromFile(ctx, serviceClient, namespace, args, &submitOpts, &cliSubmitOpts)
+				submitWorkflowsFromFile(ctx, apiClient, serviceClient, namespace, args, &submitOpts, &cliSubmitOpts)
 			}
 		},
 	}
@@ -109,7 +116,7 @@ func NewSubmitCommand() *cobra.Command {
 	return command
 }
 
-func submitWorkflowsFromFile(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, filePaths []string, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) {
+func submitWorkflowsFromFile(ctx context.Context, apiClient apiclient.Client, serviceClient workflowpkg.WorkflowServiceClient, namespace string, filePaths []string, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) {
 	fileContents, err := util.ReadManifest(filePaths...)
 	errors.CheckError(err)
 
@@ -119,7 +126,7 @@ func submitWorkflowsFromFile(ctx context.Context, serviceClient workflowpkg.Work
 		workflows = append(workflows, wfs...)
 	}
 
-	submitWorkflows(ctx, serviceClient, namespace, workflows, submitOpts, cliOpts)
+	submitWorkflows(ctx, apiClient, serviceClient, namespace, workflows, submitOpts, cliOpts)
 }
 
 func validateOptions(workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) {
@@ -197,7 +204,7 @@ func submitWorkflowFromResource(ctx context.Context, serviceClient workflowpkg.W
 	waitWatchOrLog(ctx, serviceClient, namespace, []string{created.Name}, *cliOpts)
 }
 
-func submitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) {
+func submitWorkflows(ctx context.Context, apiClient apiclient.Client, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) {
 	validateOptions(workflows, submitOpts, cliOpts)
 
 	if len(workflows) == 0 {
@@ -212,7 +219,9 @@ func submitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServ
 			// This is here to avoid passing an empty namespace when using --server-dry-run
 			wf.Namespace = namespace
 		}
-		err := util.ApplySubmitOpts(&wf, submitOpts)
+		wftmplSpec, err := client.GetWorkflowTemplateRefSpec(ctx, apiClient, wf.Namespace, &wf.Spec)
+		errors.CheckError(err)
+		err = util.ApplySubmitOpts(&wf, wftmplSpec, submitOpts)
 		errors.CheckError(err)
 		wf.Spec.Priority = cliOpts.priority
 		options := &metav1.CreateOptions{}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func NewArtifactGCCommand() *cobra.Command {
+	command := cobra.Command{
+		Use:   "artifact-gc",
+		Short: "delete the artifacts of a workflow from their locations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			var artifacts []wfv1.Artifact
+			if err := json.Unmarshal([]byte(os.Getenv(common.EnvVarArtifacts)), &artifacts); err != nil {
+				return fmt.Errorf("failed to unmarshal artifacts: %w", err)
+			}
+			return deleteArtifacts(ctx, artifacts)
+		},
+	}
+	return &command
+}
+
+// deleteArtifacts deletes every artifact, carrying on after failures so as many artifacts as possible are deleted
+func deleteArtifacts(ctx context.Context, artifacts []wfv1.Artifact) error {
+	failed := 0
+	for i := range artifacts {
+		art := &artifacts[i]
+		logCtx := log.WithField("artifact", art.Name)
+		err := artifact.DeleteArtifact(ctx, art, mountedSecrets{})
+		switch err {
+		case nil:
+			logCtx.Info("Deleted artifact")
+		case artifact.ErrDeleteUnsupported:
+			logCtx.Warn("Not deleting artifact, its driver does not support deletion")
+		default:
+			logCtx.WithError(err).Error("Failed to delete artifact")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d artifacts", failed, len(artifacts))
+	}
+	return nil
+}
+
+// mountedSecrets reads the secrets the controller mounted into the artifact GC pod
+type mountedSecrets struct{}
+
+func (mountedSecrets) GetSecret(_ context.Context, name, key string) (string, error) {
+	file, err := ioutil.ReadFile(filepath.Clean(filepath.Join(common.SecretVolMountPath, name, key)))
+	if err != nil {
+		return "", err
+	}
+	return string(file), nil
+}
+
+func (mountedSecrets) GetConfigMapKey(_ context.Context, name, key string) (string, error) {
+	return "", fmt.Errorf("cannot get key %s of configmap %s, configmaps are not available to artifact GC", key, name)
+}
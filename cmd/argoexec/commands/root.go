@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -60,6 +61,7 @@ func NewRootCommand() *cobra.Command {
 		},
 	}
 
+	command.AddCommand(NewArtifactGCCommand())
 	command.AddCommand(NewEmissaryCommand())
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
@@ -105,6 +107,13 @@ func initExecutor() *executor.WorkflowExecutor {
 	deadline, err := time.Parse(time.RFC3339, os.Getenv(common.EnvVarDeadline))
 	checkErr(err)
 
+	var artifactStorageRemaining *int64
+	if v, ok := os.LookupEnv(common.EnvVarArtifactStorageRemaining); ok {
+		remaining, err := strconv.ParseInt(v, 10, 64)
+		checkErr(err)
+		artifactStorageRemaining = &remaining
+	}
+
 	var cre executor.ContainerRuntimeExecutor
 	switch executorType {
 	case common.ContainerRuntimeExecutorK8sAPI:
@@ -121,6 +130,7 @@ func initExecutor() *executor.WorkflowExecutor {
 	checkErr(err)
 
 	wfExecutor := executor.NewExecutor(clientset, restClient, podName, namespace, cre, *tmpl, includeScriptOutput, deadline)
+	wfExecutor.ArtifactStorageRemaining = artifactStorageRemaining
 	log.
 		WithField("version", version.String()).
 		WithField("namespace", namespace).
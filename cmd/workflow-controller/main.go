@@ -54,7 +54,7 @@ func NewRootCommand() *cobra.Command {
 		qps                      float32
 		namespaced               bool   // --namespaced
 		managedNamespace         string // --managed-namespace
-
+		pprofPort                int    // --pprof-port
 	)
 
 	command := cobra.Command{
@@ -104,14 +104,32 @@ func NewRootCommand() *cobra.Command {
 			wfController, err := controller.NewWorkflowController(ctx, config, kubeclientset, wfclientset, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap)
 			errors.CheckError(err)
 
+			// flags take precedence over the config map
+			if !c.Flags().Changed("workflow-workers") && wfController.Config.WorkflowWorkers > 0 {
+				workflowWorkers = wfController.Config.WorkflowWorkers
+			}
+			if !c.Flags().Changed("pod-workers") && wfController.Config.PodWorkers > 0 {
+				podWorkers = wfController.Config.PodWorkers
+			}
+
 			go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podWorkers, podCleanupWorkers)
 
-			http.HandleFunc("/healthz", wfController.Healthz)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/healthz", wfController.Healthz)
+			mux.HandleFunc("/readyz", wfController.Readyz)
 
 			go func() {
-				log.Println(http.ListenAndServe(":6060", nil))
+				log.Println(http.ListenAndServe(":6060", mux))
 			}()
 
+			// disabled by default, for security
+			if pprofPort > 0 {
+				go func() {
+					log.Infof("starting server for pprof on :%d, see https://golang.org/pkg/net/http/pprof/", pprofPort)
+					log.Println(http.ListenAndServe(fmt.Sprintf(":%d", pprofPort), nil))
+				}()
+			}
+
 			// Wait forever
 			select {}
 		},
@@ -134,6 +152,7 @@ func NewRootCommand() *cobra.Command {
 	command.Flags().Float32Var(&qps, "qps", 20.0, "Queries per second")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run workflow-controller as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that workflow-controller watches, default to the installation namespace")
+	command.Flags().IntVar(&pprofPort, "pprof-port", 0, "Port to serve net/http/pprof profiles on, disabled if 0")
 	return &command
 }
 
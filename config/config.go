@@ -6,6 +6,7 @@ import (
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -36,6 +37,10 @@ type Config struct {
 	// Executor holds container customizations for the executor to use when running pods
 	Executor *apiv1.Container `json:"executor,omitempty"`
 
+	// ExecutorLogLevel is the logging level of the executor, one of: debug|info|warn|error.
+	// Defaults to the controller's logging level
+	ExecutorLogLevel string `json:"executorLogLevel,omitempty"`
+
 	// ExecutorResources specifies the resource requirements that will be used for the executor sidecar
 	// DEPRECATED: use `executor.resources` in configmap instead
 	ExecutorResources *apiv1.ResourceRequirements `json:"executorResources,omitempty"`
@@ -87,6 +92,12 @@ type Config struct {
 	// NamespaceParallelism limits the max workflows that can execute at the same time in a namespace
 	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
 
+	// WorkflowWorkers is the number of workflow workers, unless --workflow-workers is given
+	WorkflowWorkers int `json:"workflowWorkers,omitempty"`
+
+	// PodWorkers is the number of pod workers, unless --pod-workers is given
+	PodWorkers int `json:"podWorkers,omitempty"`
+
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
@@ -124,6 +135,11 @@ type Config struct {
 	// The command/args for each image, needed when the command is not specified and the emissary executor is used.
 	// https://argoproj.github.io/argo-workflows/workflow-executors/#emissary-emissary
 	Images map[string]Image `json:"images,omitempty"`
+
+	// ArtifactStorageLimit caps the total size of the output artifacts a single workflow may upload. Once exceeded,
+	// further uploads are refused and the workflow errors. Workflows may override this using spec.artifactStorageLimit.
+	// Logs archived as artifacts count towards the limit.
+	ArtifactStorageLimit *resource.Quantity `json:"artifactStorageLimit,omitempty"`
 }
 
 func (c Config) GetContainerRuntimeExecutor(labels labels.Labels) (string, error) {
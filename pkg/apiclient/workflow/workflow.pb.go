@@ -255,6 +255,7 @@ type WorkflowResubmitRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Memoized             bool     `protobuf:"varint,3,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	Parameters           []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -314,6 +315,13 @@ func (m *WorkflowResubmitRequest) GetMemoized() bool {
 	return false
 }
 
+func (m *WorkflowResubmitRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 type WorkflowRetryRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1280,95 +1288,96 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1408 bytes of a gzipped FileDescriptorProto
+	// 1413 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4b, 0x6f, 0x1c, 0xc5,
 	0x16, 0xc7, 0x55, 0xe3, 0xc4, 0xb1, 0xcb, 0x8f, 0x24, 0x75, 0x93, 0xdc, 0xb9, 0xad, 0xc4, 0x71,
 	0x2a, 0x37, 0xf7, 0x3a, 0x4e, 0xdc, 0xed, 0x47, 0x80, 0x04, 0x09, 0x24, 0x12, 0x07, 0x8b, 0x30,
 	0x84, 0xa8, 0x07, 0x09, 0xc1, 0x06, 0xb5, 0x7b, 0x8e, 0xdb, 0x1d, 0x4f, 0x77, 0x35, 0x55, 0x35,
 	0x13, 0x99, 0x10, 0x24, 0xd8, 0xc0, 0x02, 0x89, 0x05, 0x4b, 0x36, 0x08, 0x81, 0x60, 0x81, 0x00,
 	0x21, 0x21, 0x90, 0x90, 0x10, 0x4b, 0x96, 0x91, 0xd8, 0xb2, 0x40, 0x11, 0x5f, 0x80, 0x6f, 0x80,
-	0xaa, 0xfa, 0xed, 0x99, 0x0c, 0x8d, 0x3d, 0x81, 0xec, 0xaa, 0xba, 0xbb, 0xea, 0xfc, 0xea, 0x7f,
-	0xaa, 0xce, 0x39, 0xd5, 0xf8, 0x4c, 0xb4, 0xe5, 0x59, 0x4e, 0xe4, 0xbb, 0x6d, 0x1f, 0x42, 0x69,
-	0xdd, 0x62, 0x7c, 0x6b, 0xa3, 0xcd, 0x6e, 0x65, 0x0d, 0x33, 0xe2, 0x4c, 0x32, 0x32, 0x96, 0xf6,
-	0x8d, 0xe3, 0x1e, 0x63, 0x5e, 0x1b, 0xd4, 0x18, 0xcb, 0x09, 0x43, 0x26, 0x1d, 0xe9, 0xb3, 0x50,
-	0xc4, 0xdf, 0x19, 0x17, 0xb6, 0x2e, 0x0a, 0xd3, 0x67, 0xea, 0x6d, 0xe0, 0xb8, 0x9b, 0x7e, 0x08,
-	0x7c, 0xdb, 0x4a, 0x4c, 0x08, 0x2b, 0x00, 0xe9, 0x58, 0xdd, 0x25, 0xcb, 0x83, 0x10, 0xb8, 0x23,
-	0xa1, 0x95, 0x8c, 0x7a, 0xce, 0xf3, 0xe5, 0x66, 0x67, 0xdd, 0x74, 0x59, 0x60, 0x39, 0xdc, 0x63,
-	0x11, 0x67, 0x37, 0x75, 0x63, 0x21, 0x35, 0x2b, 0xf2, 0x49, 0x32, 0xc4, 0xee, 0x92, 0xd3, 0x8e,
-	0x36, 0x9d, 0xde, 0xe9, 0x68, 0x0e, 0x61, 0xb9, 0x8c, 0x43, 0x1f, 0x93, 0xf4, 0xc7, 0x1a, 0x3e,
-	0xfa, 0x62, 0x32, 0xd3, 0x15, 0x0e, 0x8e, 0x04, 0x1b, 0x5e, 0xed, 0x80, 0x90, 0xe4, 0x38, 0x1e,
-	0x0f, 0x9d, 0x00, 0x44, 0xe4, 0xb8, 0x50, 0x47, 0xb3, 0x68, 0x6e, 0xdc, 0xce, 0x1f, 0x90, 0x0d,
-	0x9c, 0x49, 0x51, 0xaf, 0xcd, 0xa2, 0xb9, 0x89, 0xe5, 0x6b, 0x66, 0x4e, 0x6f, 0xa6, 0xf4, 0xba,
-	0xf1, 0x4a, 0x46, 0x6f, 0x76, 0x57, 0xcc, 0x68, 0xcb, 0x33, 0xd5, 0x02, 0xcc, 0x4c, 0xda, 0x74,
-	0x01, 0x66, 0x0a, 0x62, 0x67, 0x73, 0x13, 0x8a, 0xb1, 0x1f, 0x0a, 0xe9, 0x84, 0x2e, 0x3c, 0xb3,
-	0x5a, 0x1f, 0x51, 0x18, 0x97, 0x6b, 0x75, 0x64, 0x17, 0x9e, 0x12, 0x8a, 0x27, 0x05, 0xf0, 0x2e,
-	0xf0, 0x55, 0xbe, 0x6d, 0x77, 0xc2, 0xfa, 0xbe, 0x59, 0x34, 0x37, 0x66, 0x97, 0x9e, 0x91, 0x97,
-	0xf0, 0x94, 0xab, 0x97, 0xf7, 0x7c, 0xa4, 0xfd, 0x54, 0xdf, 0xaf, 0xa1, 0x57, 0xcc, 0x58, 0x23,
-	0xb3, 0xe8, 0xa8, 0x1c, 0x51, 0x39, 0xca, 0xec, 0x2e, 0x99, 0x57, 0x8a, 0x43, 0xed, 0xf2, 0x4c,
-	0xf4, 0x2b, 0x84, 0x49, 0x4a, 0xbe, 0x06, 0x32, 0xd5, 0x8f, 0xe0, 0x7d, 0x4a, 0xae, 0x44, 0x3a,
-	0xdd, 0x2e, 0x6b, 0x5a, 0xdb, 0xa9, 0xe9, 0x0d, 0x8c, 0x3d, 0x90, 0x29, 0xe0, 0x88, 0x06, 0x5c,
-	0xac, 0x06, 0xb8, 0x96, 0x8d, 0xb3, 0x0b, 0x73, 0x90, 0x63, 0x78, 0x74, 0xc3, 0x87, 0x76, 0x4b,
-	0x68, 0x4d, 0xc6, 0xed, 0xa4, 0x47, 0x3f, 0x44, 0xf8, 0x5f, 0x29, 0x72, 0xc3, 0x17, 0xb2, 0x9a,
-	0xcf, 0x9b, 0x78, 0xa2, 0xed, 0x8b, 0x0c, 0x30, 0x76, 0xfb, 0x52, 0x35, 0xc0, 0x46, 0x3e, 0xd0,
-	0x2e, 0xce, 0x52, 0x40, 0x1c, 0x29, 0x21, 0x7a, 0xf8, 0xdf, 0xd9, 0x76, 0x00, 0xd1, 0x59, 0x0f,
-	0xfc, 0x3d, 0x28, 0x6b, 0xe0, 0xb1, 0x00, 0x02, 0xe6, 0xbf, 0x06, 0x2d, 0x6d, 0x66, 0xcc, 0xce,
-	0xfa, 0xf4, 0x63, 0x84, 0x8f, 0xe4, 0x96, 0x24, 0xdf, 0xde, 0xbd, 0x99, 0xf3, 0xf8, 0x30, 0x07,
-	0x21, 0x1d, 0x2e, 0x9b, 0x1d, 0xd7, 0x05, 0x21, 0x36, 0x3a, 0xed, 0xc4, 0x5e, 0xef, 0x0b, 0xf5,
-	0x75, 0xc8, 0x5a, 0xf0, 0xb4, 0x5a, 0x6f, 0x13, 0xda, 0xe0, 0x4a, 0xc6, 0x13, 0x3f, 0xf5, 0xbe,
-	0xa0, 0xb7, 0xf2, 0x73, 0xaa, 0xf4, 0x08, 0x60, 0x4f, 0x98, 0xbd, 0x86, 0x47, 0xee, 0x67, 0xb8,
-	0x81, 0xeb, 0xa9, 0xe1, 0x17, 0x80, 0x07, 0x7e, 0x58, 0x88, 0x11, 0x7f, 0xd9, 0x36, 0x7d, 0xaf,
-	0xb0, 0xf3, 0x9a, 0x92, 0x45, 0x7f, 0xd3, 0x2a, 0x48, 0x1d, 0x1f, 0x08, 0x40, 0x08, 0xc7, 0x83,
-	0x44, 0xe2, 0xb4, 0x4b, 0xef, 0x16, 0x8e, 0x6f, 0x73, 0x2f, 0xc7, 0x77, 0x48, 0x40, 0xe4, 0x08,
-	0xde, 0x1f, 0x6d, 0x3a, 0x02, 0x74, 0x88, 0x1a, 0xb7, 0xe3, 0x0e, 0x99, 0xc7, 0x87, 0x58, 0x47,
-	0x46, 0x1d, 0x79, 0xc3, 0xe1, 0x4e, 0x00, 0x12, 0xb8, 0xa8, 0x8f, 0xea, 0x0f, 0x7a, 0x9e, 0xd3,
-	0x6b, 0xf8, 0x58, 0xb6, 0xa2, 0x8e, 0x88, 0x20, 0x6c, 0xed, 0xde, 0x61, 0xdf, 0x16, 0xe4, 0x69,
-	0x30, 0x6f, 0xf7, 0xf2, 0xd4, 0xf1, 0x81, 0x88, 0xb5, 0xae, 0xab, 0x41, 0xb1, 0x28, 0x69, 0x97,
-	0x3c, 0x85, 0x71, 0x9b, 0x79, 0x69, 0x58, 0xd9, 0xa7, 0xc3, 0xca, 0xa9, 0x42, 0x58, 0x31, 0x55,
-	0xf2, 0x52, 0x41, 0xe4, 0x06, 0x6b, 0x35, 0xb2, 0x0f, 0xed, 0xc2, 0x20, 0x85, 0xe3, 0x71, 0x88,
-	0x12, 0xc9, 0x74, 0x5b, 0x1d, 0xec, 0xec, 0xc8, 0xac, 0x42, 0x1b, 0xf6, 0xb0, 0x6d, 0x55, 0xfa,
-	0x68, 0xe9, 0x29, 0xca, 0xd1, 0xb9, 0x62, 0xfa, 0x58, 0x2d, 0x0e, 0xb5, 0xcb, 0x33, 0xd1, 0x7a,
-	0xee, 0xac, 0x94, 0x52, 0x44, 0x2c, 0x14, 0x40, 0x3f, 0x52, 0x0b, 0x70, 0xa4, 0xbb, 0x99, 0xbe,
-	0x17, 0x0f, 0x61, 0x9c, 0x7e, 0xb7, 0xb0, 0x3f, 0x34, 0xec, 0xd5, 0x2e, 0x84, 0x5a, 0x62, 0xb9,
-	0x1d, 0x65, 0x12, 0xab, 0x36, 0x59, 0xc7, 0xa3, 0x6c, 0xfd, 0x26, 0xb8, 0xf2, 0x01, 0x54, 0x0c,
-	0xc9, 0xcc, 0xf4, 0x6d, 0x85, 0x93, 0x61, 0xfc, 0x83, 0x82, 0xd1, 0x27, 0xf1, 0x58, 0x83, 0x79,
-	0x57, 0x43, 0xc9, 0xb7, 0xd5, 0xde, 0x77, 0x59, 0x28, 0x21, 0x94, 0x89, 0xf1, 0xb4, 0x5b, 0x3c,
-	0x15, 0xb5, 0xd2, 0xa9, 0xa0, 0x1f, 0x94, 0x72, 0x74, 0x28, 0x1f, 0xaa, 0xba, 0x8c, 0xfe, 0x5e,
-	0x38, 0x5c, 0xcd, 0x52, 0x76, 0x1e, 0xcc, 0x47, 0xf1, 0x24, 0x07, 0xc1, 0x3a, 0xdc, 0x85, 0x67,
-	0xfd, 0xb0, 0x95, 0x2c, 0xba, 0xf4, 0xac, 0xf8, 0x4d, 0x21, 0x5c, 0x94, 0x9e, 0x11, 0x8e, 0xa7,
-	0xe2, 0xa2, 0xa0, 0x1c, 0x36, 0x1a, 0x7b, 0x5f, 0x6c, 0x33, 0x9d, 0x56, 0xd8, 0x65, 0x13, 0xcb,
-	0xbf, 0x1c, 0xc5, 0x07, 0xf3, 0x4c, 0xc1, 0xbb, 0xbe, 0x0b, 0xe4, 0x53, 0x84, 0xa7, 0xe3, 0xea,
-	0x30, 0x7d, 0x43, 0x4e, 0xe6, 0x93, 0xf6, 0xad, 0xac, 0x8d, 0x21, 0x7a, 0x84, 0xce, 0xbd, 0xf5,
-	0xf3, 0x6f, 0xef, 0xd7, 0x28, 0x3d, 0xa1, 0xab, 0xfc, 0xee, 0x92, 0x95, 0xdf, 0x14, 0x6e, 0x67,
-	0xaa, 0xdf, 0x79, 0x1c, 0xcd, 0x93, 0x4f, 0x10, 0x9e, 0x58, 0x03, 0x99, 0x61, 0x1e, 0xef, 0xc5,
-	0xcc, 0xab, 0xd7, 0xa1, 0x32, 0x9e, 0xd7, 0x8c, 0xff, 0x23, 0xff, 0x1d, 0xc8, 0x18, 0xb7, 0xef,
-	0x28, 0xce, 0x29, 0x75, 0xa8, 0xb2, 0xa0, 0x47, 0x4e, 0xf4, 0x92, 0x16, 0x8a, 0x56, 0xe3, 0xfa,
-	0xf0, 0x50, 0xd5, 0xb4, 0xf4, 0x8c, 0xc6, 0x3d, 0x49, 0x06, 0x4b, 0x4a, 0xde, 0xc0, 0xd3, 0xe5,
-	0xe0, 0x5c, 0x72, 0x7c, 0xbf, 0xb0, 0x6d, 0xf4, 0x91, 0x3c, 0x8f, 0x55, 0xf4, 0x9c, 0xb6, 0x7b,
-	0x86, 0x9c, 0xde, 0x69, 0x77, 0x01, 0x74, 0x2c, 0x2b, 0x5a, 0x5f, 0x44, 0x44, 0xe0, 0x89, 0x42,
-	0xa0, 0x2b, 0xb9, 0xb3, 0x27, 0xfe, 0x19, 0xff, 0xe9, 0x97, 0x4e, 0x63, 0xb3, 0x67, 0xb5, 0xd9,
-	0xd3, 0xe4, 0x54, 0x6a, 0x56, 0x48, 0x0e, 0x4e, 0x60, 0xf5, 0x35, 0xfa, 0x26, 0xc2, 0xd3, 0x71,
-	0x96, 0x1a, 0xb4, 0xdd, 0x4b, 0xd9, 0xd6, 0x98, 0xbd, 0xff, 0x07, 0x49, 0xa2, 0x4b, 0x36, 0xc8,
-	0x7c, 0xb5, 0x0d, 0xf2, 0x35, 0xc2, 0x53, 0xba, 0x50, 0xcf, 0x10, 0x66, 0x7a, 0x2d, 0x14, 0x2b,
-	0xf9, 0xa1, 0x6e, 0xe6, 0x47, 0x34, 0xab, 0x65, 0xcc, 0x57, 0x61, 0xb5, 0xb8, 0xc2, 0x50, 0xa7,
-	0xef, 0x7b, 0x84, 0x0f, 0xa5, 0xf7, 0x98, 0x8c, 0xfb, 0x54, 0x3f, 0xee, 0xd2, 0x5d, 0x67, 0xa8,
-	0xe8, 0x17, 0x35, 0xfa, 0xb2, 0xb1, 0x50, 0x11, 0x3d, 0x26, 0x51, 0xf4, 0xdf, 0x20, 0x3c, 0x1d,
-	0xdf, 0x3a, 0x06, 0xb9, 0xbd, 0x74, 0x2f, 0x19, 0x2a, 0xf9, 0xa3, 0x9a, 0x7c, 0xd1, 0x38, 0x57,
-	0x99, 0x3c, 0x00, 0xc5, 0xfd, 0x1d, 0xc2, 0x07, 0x93, 0x0a, 0x38, 0x03, 0xef, 0xb3, 0x1d, 0xcb,
-	0x45, 0xf2, 0x50, 0xc9, 0x1f, 0xd3, 0xe4, 0x4b, 0xc6, 0xf9, 0x4a, 0xe4, 0x22, 0x06, 0x51, 0xe8,
-	0x3f, 0x20, 0x7c, 0x38, 0xbb, 0x6f, 0x65, 0xf0, 0xb4, 0x17, 0x7e, 0xe7, 0xa5, 0x6c, 0xa8, 0xf8,
-	0x97, 0x34, 0xfe, 0x8a, 0x61, 0x56, 0xc2, 0x97, 0x29, 0x8a, 0x5a, 0xc0, 0x97, 0x08, 0x4f, 0xaa,
-	0x1b, 0x5e, 0xc6, 0xde, 0x27, 0x8c, 0x17, 0x6e, 0x80, 0x43, 0xc5, 0xbe, 0xa0, 0xb1, 0x4d, 0xe3,
-	0x6c, 0x35, 0xd5, 0x25, 0x8b, 0x14, 0xf1, 0xe7, 0x08, 0x4f, 0x34, 0x07, 0x67, 0xc8, 0xe6, 0x83,
-	0xc9, 0x90, 0x2b, 0x9a, 0x77, 0xc1, 0x98, 0xab, 0xc6, 0x0b, 0xfa, 0x50, 0x7e, 0x86, 0xf0, 0xa4,
-	0x2a, 0x0c, 0x07, 0x09, 0x5c, 0x28, 0x1c, 0x87, 0x0a, 0xbc, 0xa0, 0x81, 0xff, 0x4f, 0xe9, 0x60,
-	0xe0, 0xb6, 0x1f, 0x6a, 0xd4, 0xd7, 0xf1, 0x81, 0xf8, 0xee, 0x26, 0xfa, 0x89, 0x9a, 0x5f, 0x2b,
-	0x0d, 0x92, 0xbf, 0x4d, 0x8b, 0x67, 0xfa, 0x84, 0xb6, 0x75, 0x81, 0x2c, 0x57, 0x12, 0xe7, 0x76,
-	0x52, 0x3f, 0xdf, 0xb1, 0xda, 0xcc, 0x7b, 0xa7, 0x86, 0x16, 0x11, 0x91, 0x78, 0xb2, 0x60, 0x6a,
-	0x37, 0x08, 0x8b, 0x1a, 0x61, 0x9e, 0x54, 0xf3, 0x4f, 0x9b, 0x79, 0x8b, 0x88, 0x7c, 0x81, 0xf0,
-	0x74, 0xb3, 0x1c, 0xef, 0x4f, 0xf6, 0x0b, 0x3d, 0x0f, 0x2a, 0xda, 0x5b, 0x9a, 0xf9, 0x2c, 0xfd,
-	0x93, 0xa4, 0x9a, 0x05, 0xf9, 0xcb, 0x6b, 0x3f, 0xdd, 0x9b, 0x41, 0x77, 0xef, 0xcd, 0xa0, 0x5f,
-	0xef, 0xcd, 0xa0, 0x97, 0x2f, 0x55, 0xff, 0x17, 0xbd, 0xe3, 0x9f, 0xf9, 0xfa, 0xa8, 0xfe, 0xb5,
-	0xbc, 0xf2, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0xa6, 0x8f, 0x98, 0x54, 0x17, 0x00, 0x00,
+	0xaa, 0xfa, 0xed, 0x99, 0x0c, 0x2d, 0x7b, 0x42, 0xb2, 0xab, 0x47, 0x57, 0x9d, 0x5f, 0xfd, 0xab,
+	0xea, 0x9c, 0x53, 0x8d, 0xcf, 0x44, 0x5b, 0x9e, 0xe5, 0x44, 0xbe, 0xdb, 0xf6, 0x21, 0x94, 0xd6,
+	0x2d, 0xc6, 0xb7, 0x36, 0xda, 0xec, 0x56, 0x56, 0x30, 0x23, 0xce, 0x24, 0x23, 0x63, 0x69, 0xdd,
+	0x38, 0xee, 0x31, 0xe6, 0xb5, 0x41, 0x8d, 0xb1, 0x9c, 0x30, 0x64, 0xd2, 0x91, 0x3e, 0x0b, 0x45,
+	0xfc, 0x9d, 0x71, 0x61, 0xeb, 0xa2, 0x30, 0x7d, 0xa6, 0x7a, 0x03, 0xc7, 0xdd, 0xf4, 0x43, 0xe0,
+	0xdb, 0x56, 0x62, 0x42, 0x58, 0x01, 0x48, 0xc7, 0xea, 0x2e, 0x59, 0x1e, 0x84, 0xc0, 0x1d, 0x09,
+	0xad, 0x64, 0xd4, 0x0b, 0x9e, 0x2f, 0x37, 0x3b, 0xeb, 0xa6, 0xcb, 0x02, 0xcb, 0xe1, 0x1e, 0x8b,
+	0x38, 0xbb, 0xa9, 0x0b, 0x0b, 0xa9, 0x59, 0x91, 0x4f, 0x92, 0x21, 0x76, 0x97, 0x9c, 0x76, 0xb4,
+	0xe9, 0xf4, 0x4e, 0x47, 0x73, 0x08, 0xcb, 0x65, 0x1c, 0xfa, 0x98, 0xa4, 0x3f, 0xd7, 0xf0, 0xd1,
+	0x97, 0x93, 0x99, 0xae, 0x70, 0x70, 0x24, 0xd8, 0xf0, 0x7a, 0x07, 0x84, 0x24, 0xc7, 0xf1, 0x78,
+	0xe8, 0x04, 0x20, 0x22, 0xc7, 0x85, 0x3a, 0x9a, 0x45, 0x73, 0xe3, 0x76, 0xde, 0x40, 0x36, 0x70,
+	0x26, 0x45, 0xbd, 0x36, 0x8b, 0xe6, 0x26, 0x96, 0xaf, 0x99, 0x39, 0xbd, 0x99, 0xd2, 0xeb, 0xc2,
+	0x6b, 0x19, 0xbd, 0xd9, 0x5d, 0x31, 0xa3, 0x2d, 0xcf, 0x54, 0x0b, 0x30, 0xd3, 0x56, 0x33, 0x5d,
+	0x80, 0x99, 0x82, 0xd8, 0xd9, 0xdc, 0x84, 0x62, 0xec, 0x87, 0x42, 0x3a, 0xa1, 0x0b, 0xcf, 0xad,
+	0xd6, 0x47, 0x14, 0xc6, 0xe5, 0x5a, 0x1d, 0xd9, 0x85, 0x56, 0x42, 0xf1, 0xa4, 0x00, 0xde, 0x05,
+	0xbe, 0xca, 0xb7, 0xed, 0x4e, 0x58, 0xdf, 0x37, 0x8b, 0xe6, 0xc6, 0xec, 0x52, 0x1b, 0x79, 0x05,
+	0x4f, 0xb9, 0x7a, 0x79, 0x2f, 0x46, 0x7a, 0x9f, 0xea, 0xfb, 0x35, 0xf4, 0x8a, 0x19, 0x6b, 0x64,
+	0x16, 0x37, 0x2a, 0x47, 0x54, 0x1b, 0x65, 0x76, 0x97, 0xcc, 0x2b, 0xc5, 0xa1, 0x76, 0x79, 0x26,
+	0xfa, 0x0d, 0xc2, 0x24, 0x25, 0x5f, 0x03, 0x99, 0xea, 0x47, 0xf0, 0x3e, 0x25, 0x57, 0x22, 0x9d,
+	0x2e, 0x97, 0x35, 0xad, 0xed, 0xd4, 0xf4, 0x06, 0xc6, 0x1e, 0xc8, 0x14, 0x70, 0x44, 0x03, 0x2e,
+	0x56, 0x03, 0x5c, 0xcb, 0xc6, 0xd9, 0x85, 0x39, 0xc8, 0x31, 0x3c, 0xba, 0xe1, 0x43, 0xbb, 0x25,
+	0xb4, 0x26, 0xe3, 0x76, 0x52, 0xa3, 0x1f, 0x23, 0xfc, 0xaf, 0x14, 0xb9, 0xe1, 0x0b, 0x59, 0x6d,
+	0xcf, 0x9b, 0x78, 0xa2, 0xed, 0x8b, 0x0c, 0x30, 0xde, 0xf6, 0xa5, 0x6a, 0x80, 0x8d, 0x7c, 0xa0,
+	0x5d, 0x9c, 0xa5, 0x80, 0x38, 0x52, 0x42, 0x7c, 0x17, 0xe1, 0x7f, 0x67, 0xe7, 0x01, 0x44, 0x67,
+	0x3d, 0xf0, 0xf7, 0x20, 0xad, 0x81, 0xc7, 0x02, 0x08, 0x98, 0xff, 0x06, 0xb4, 0xb4, 0x9d, 0x31,
+	0x3b, 0xab, 0x93, 0x19, 0x8c, 0x23, 0x87, 0x3b, 0x01, 0x48, 0xe0, 0x4a, 0xa8, 0x91, 0xb9, 0x71,
+	0xbb, 0xd0, 0x42, 0x3f, 0x45, 0xf8, 0x48, 0x4e, 0x22, 0xf9, 0xf6, 0xee, 0x31, 0xce, 0xe3, 0xc3,
+	0x1c, 0x84, 0x74, 0xb8, 0x6c, 0x76, 0x5c, 0x17, 0x84, 0xd8, 0xe8, 0xb4, 0x13, 0x9e, 0xde, 0x0e,
+	0xf5, 0x75, 0xc8, 0x5a, 0xf0, 0xac, 0x12, 0xa4, 0x09, 0x6d, 0x70, 0x25, 0xe3, 0xc9, 0x46, 0xf6,
+	0x76, 0xd0, 0x5b, 0xf8, 0x68, 0x51, 0xaf, 0x00, 0xf6, 0x84, 0xd9, 0x6b, 0x78, 0xe4, 0x7e, 0x86,
+	0x1b, 0xb8, 0x9e, 0x1a, 0x7e, 0x09, 0x78, 0xe0, 0x87, 0x8e, 0xdc, 0xbd, 0x6d, 0xfa, 0x41, 0xe1,
+	0x68, 0x36, 0x25, 0x8b, 0xfe, 0xa1, 0x55, 0x90, 0x3a, 0x3e, 0x10, 0x80, 0x10, 0x8e, 0x07, 0x89,
+	0xc4, 0x69, 0x95, 0xde, 0x2d, 0xdc, 0xef, 0x26, 0xc8, 0x87, 0x0e, 0x44, 0x8e, 0xe0, 0xfd, 0xd1,
+	0xa6, 0x23, 0x40, 0xfb, 0xb0, 0x71, 0x3b, 0xae, 0x90, 0x79, 0x7c, 0x88, 0x75, 0x64, 0xd4, 0x91,
+	0x37, 0xf2, 0xc3, 0x3c, 0xaa, 0x3f, 0xe8, 0x69, 0xa7, 0xd7, 0xf0, 0xb1, 0x6c, 0x45, 0x1d, 0x11,
+	0x41, 0xd8, 0xda, 0xfd, 0x86, 0x7d, 0x5f, 0x90, 0xa7, 0xc1, 0xbc, 0xdd, 0xcb, 0x53, 0xc7, 0x07,
+	0x22, 0xd6, 0xba, 0xae, 0x06, 0xc5, 0xa2, 0xa4, 0x55, 0xf2, 0x0c, 0xc6, 0x6d, 0xe6, 0xa5, 0x7e,
+	0x67, 0x9f, 0xf6, 0x3b, 0xa7, 0x0a, 0x7e, 0xc7, 0x54, 0xd1, 0x4d, 0x79, 0x99, 0x1b, 0xac, 0xd5,
+	0xc8, 0x3e, 0xb4, 0x0b, 0x83, 0x14, 0x8e, 0xc7, 0x21, 0x4a, 0x24, 0xd3, 0x65, 0x75, 0xb1, 0xb3,
+	0x2b, 0xb3, 0x0a, 0x6d, 0xd8, 0xc3, 0xb1, 0x55, 0xf1, 0xa5, 0xa5, 0xa7, 0x28, 0xbb, 0xef, 0x8a,
+	0xf1, 0x65, 0xb5, 0x38, 0xd4, 0x2e, 0xcf, 0x44, 0xeb, 0xf9, 0x66, 0xa5, 0x94, 0x22, 0x62, 0xa1,
+	0x00, 0xfa, 0x89, 0x5a, 0x80, 0x23, 0xdd, 0xcd, 0xb4, 0x5f, 0x3c, 0x82, 0x8e, 0xfc, 0xfd, 0xc2,
+	0xf9, 0xd0, 0xb0, 0x57, 0xbb, 0x10, 0x6a, 0x89, 0xe5, 0x76, 0x94, 0x49, 0xac, 0xca, 0x64, 0x1d,
+	0x8f, 0xb2, 0xf5, 0x9b, 0xe0, 0xca, 0x07, 0x90, 0x52, 0x24, 0x33, 0xab, 0xb8, 0x42, 0x72, 0x8c,
+	0x87, 0x28, 0x18, 0x7d, 0x1a, 0x8f, 0x35, 0x98, 0x77, 0x35, 0x94, 0x7c, 0x5b, 0x9d, 0x7d, 0x97,
+	0x85, 0x12, 0x42, 0x99, 0x18, 0x4f, 0xab, 0xc5, 0x5b, 0x51, 0x2b, 0xdd, 0x0a, 0xfa, 0x51, 0x29,
+	0x88, 0x87, 0xf2, 0x91, 0x4a, 0xdc, 0xe8, 0x9f, 0x85, 0xcb, 0xd5, 0x2c, 0x45, 0xef, 0xc1, 0x7c,
+	0x14, 0x4f, 0x72, 0x10, 0xac, 0xc3, 0x5d, 0x78, 0xde, 0x0f, 0x5b, 0xc9, 0xa2, 0x4b, 0x6d, 0xc5,
+	0x6f, 0x0a, 0xee, 0xa2, 0xd4, 0x46, 0x38, 0x9e, 0x8a, 0x93, 0x86, 0xb2, 0xdb, 0x68, 0xec, 0x7d,
+	0xb1, 0xcd, 0x74, 0x5a, 0x61, 0x97, 0x4d, 0x2c, 0xff, 0x76, 0x14, 0x1f, 0xcc, 0x23, 0x05, 0xef,
+	0xfa, 0x2e, 0x90, 0xcf, 0x11, 0x9e, 0x8e, 0xd3, 0xc7, 0xb4, 0x87, 0x9c, 0xcc, 0x27, 0xed, 0x9b,
+	0x7a, 0x1b, 0x43, 0xdc, 0x11, 0x3a, 0xf7, 0xce, 0xaf, 0x7f, 0x7c, 0x58, 0xa3, 0xf4, 0x84, 0x7e,
+	0x06, 0x74, 0x97, 0xac, 0xfc, 0x29, 0x71, 0x3b, 0x53, 0xfd, 0xce, 0x93, 0x68, 0x9e, 0x7c, 0x86,
+	0xf0, 0xc4, 0x1a, 0xc8, 0x0c, 0xf3, 0x78, 0x2f, 0x66, 0x9e, 0xde, 0x0e, 0x95, 0xf1, 0xbc, 0x66,
+	0xfc, 0x1f, 0xf9, 0xef, 0x40, 0xc6, 0xb8, 0x7c, 0x47, 0x71, 0x4e, 0xa9, 0x4b, 0x95, 0x0e, 0x17,
+	0xe4, 0x44, 0x2f, 0x69, 0x21, 0xab, 0x35, 0xae, 0x0f, 0x0f, 0x55, 0x4d, 0x4b, 0xcf, 0x68, 0xdc,
+	0x93, 0x64, 0xb0, 0xa4, 0xe4, 0x2d, 0x3c, 0x5d, 0x76, 0xce, 0xa5, 0x8d, 0xef, 0xe7, 0xb6, 0x8d,
+	0x3e, 0x92, 0xe7, 0xbe, 0x8a, 0x9e, 0xd3, 0x76, 0xcf, 0x90, 0xd3, 0x3b, 0xed, 0x2e, 0x80, 0xea,
+	0x2f, 0x59, 0x5f, 0x44, 0x44, 0xe0, 0x89, 0x7c, 0xb0, 0x28, 0x6d, 0x67, 0x8f, 0xff, 0x33, 0xfe,
+	0xd3, 0x2f, 0x9c, 0xc6, 0x66, 0xcf, 0x6a, 0xb3, 0xa7, 0xc9, 0xa9, 0xd4, 0xac, 0x90, 0x1c, 0x9c,
+	0xc0, 0xea, 0x6b, 0xf4, 0x6d, 0x84, 0xa7, 0xe3, 0x28, 0x35, 0xe8, 0xb8, 0x97, 0xa2, 0xad, 0x31,
+	0x7b, 0xff, 0x0f, 0x92, 0x40, 0x97, 0x1c, 0x90, 0xf9, 0x6a, 0x07, 0xe4, 0x5b, 0x84, 0xa7, 0x74,
+	0xa2, 0x9e, 0x21, 0xcc, 0xf4, 0x5a, 0x28, 0x66, 0xf2, 0x43, 0x3d, 0xcc, 0x8f, 0x69, 0x56, 0xcb,
+	0x98, 0xaf, 0xc2, 0x6a, 0x71, 0x85, 0xa1, 0x6e, 0xdf, 0x8f, 0x08, 0x1f, 0x4a, 0xdf, 0x39, 0x19,
+	0xf7, 0xa9, 0x7e, 0xdc, 0xa5, 0xb7, 0xd0, 0x50, 0xd1, 0x2f, 0x6a, 0xf4, 0x65, 0x63, 0xa1, 0x22,
+	0x7a, 0x4c, 0xa2, 0xe8, 0xbf, 0x43, 0x78, 0x3a, 0x7e, 0x75, 0x0c, 0xda, 0xf6, 0xd2, 0xbb, 0x64,
+	0xa8, 0xe4, 0x8f, 0x6b, 0xf2, 0x45, 0xe3, 0x5c, 0x65, 0xf2, 0x00, 0x14, 0xf7, 0x0f, 0x08, 0x1f,
+	0x4c, 0x32, 0xe0, 0x0c, 0xbc, 0xcf, 0x71, 0x2c, 0x27, 0xc9, 0x43, 0x25, 0x7f, 0x42, 0x93, 0x2f,
+	0x19, 0xe7, 0x2b, 0x91, 0x8b, 0x18, 0x44, 0xa1, 0xff, 0x84, 0xf0, 0xe1, 0xec, 0xbd, 0x95, 0xc1,
+	0xd3, 0x5e, 0xf8, 0x9d, 0x8f, 0xb2, 0xa1, 0xe2, 0x5f, 0xd2, 0xf8, 0x2b, 0x86, 0x59, 0x09, 0x5f,
+	0xa6, 0x28, 0x6a, 0x01, 0x5f, 0x23, 0x3c, 0xa9, 0x5e, 0x78, 0x19, 0x7b, 0x1f, 0x37, 0x5e, 0x78,
+	0x01, 0x0e, 0x15, 0xfb, 0x82, 0xc6, 0x36, 0x8d, 0xb3, 0xd5, 0x54, 0x97, 0x2c, 0x52, 0xc4, 0x5f,
+	0x22, 0x3c, 0xd1, 0x1c, 0x1c, 0x21, 0x9b, 0x0f, 0x26, 0x42, 0xae, 0x68, 0xde, 0x05, 0x63, 0xae,
+	0x1a, 0x2f, 0xe8, 0x4b, 0xf9, 0x05, 0xc2, 0x93, 0x2a, 0x31, 0x1c, 0x24, 0x70, 0x21, 0x71, 0x1c,
+	0x2a, 0xf0, 0x82, 0x06, 0xfe, 0x3f, 0xa5, 0x83, 0x81, 0xdb, 0x7e, 0xa8, 0x51, 0xdf, 0xc4, 0x07,
+	0xe2, 0xb7, 0x9b, 0xe8, 0x27, 0x6a, 0xfe, 0xac, 0x34, 0x48, 0xde, 0x9b, 0x26, 0xcf, 0xf4, 0x29,
+	0x6d, 0xeb, 0x02, 0x59, 0xae, 0x24, 0xce, 0xed, 0x24, 0x7f, 0xbe, 0x63, 0xb5, 0x99, 0xf7, 0x5e,
+	0x0d, 0x2d, 0x22, 0x22, 0xf1, 0x64, 0xc1, 0xd4, 0x6e, 0x10, 0x16, 0x35, 0xc2, 0x3c, 0xa9, 0xb6,
+	0x3f, 0x6d, 0xe6, 0x2d, 0x22, 0xf2, 0x15, 0xc2, 0xd3, 0xcd, 0xb2, 0xbf, 0x3f, 0xd9, 0xcf, 0xf5,
+	0x3c, 0x28, 0x6f, 0x6f, 0x69, 0xe6, 0xb3, 0xf4, 0x6f, 0x82, 0x6a, 0xe6, 0xe4, 0x2f, 0xaf, 0xfd,
+	0x72, 0x6f, 0x06, 0xdd, 0xbd, 0x37, 0x83, 0x7e, 0xbf, 0x37, 0x83, 0x5e, 0xbd, 0x54, 0xfd, 0x67,
+	0xf5, 0x8e, 0x9f, 0xea, 0xeb, 0xa3, 0xfa, 0xdf, 0xf3, 0xca, 0x5f, 0x03, 0x00, 0xc2, 0x5f, 0xb9,
+	0xab, 0x75, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2351,6 +2360,15 @@ func (m *WorkflowResubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Memoized {
 		i--
 		if m.Memoized {
@@ -3240,6 +3258,12 @@ func (m *WorkflowResubmitRequest) Size() (n int) {
 	if m.Memoized {
 		n += 2
 	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4261,6 +4285,38 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Memoized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"fmt"
 	"path"
+	"strings"
 )
 
 var (
@@ -24,6 +25,8 @@ type ArtifactRepository struct {
 	OSS *OSSArtifactRepository `json:"oss,omitempty" protobuf:"bytes,5,opt,name=oss"`
 	// GCS stores artifact in a GCS object store
 	GCS *GCSArtifactRepository `json:"gcs,omitempty" protobuf:"bytes,6,opt,name=gcs"`
+	// Azure stores artifact in an Azure Storage account
+	Azure *AzureArtifactRepository `json:"azure,omitempty" protobuf:"bytes,7,opt,name=azure"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
@@ -39,6 +42,8 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 		return nil
 	} else if a.Artifactory != nil {
 		return a.Artifactory
+	} else if a.Azure != nil {
+		return a.Azure
 	} else if a.GCS != nil {
 		return a.GCS
 	} else if a.HDFS != nil {
@@ -51,6 +56,36 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 	return nil
 }
 
+// Validate returns an error if more than one type of artifact repository is configured
+func (a *ArtifactRepository) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var types []string
+	if a.Artifactory != nil {
+		types = append(types, "artifactory")
+	}
+	if a.Azure != nil {
+		types = append(types, "azure")
+	}
+	if a.GCS != nil {
+		types = append(types, "gcs")
+	}
+	if a.HDFS != nil {
+		types = append(types, "hdfs")
+	}
+	if a.OSS != nil {
+		types = append(types, "oss")
+	}
+	if a.S3 != nil {
+		types = append(types, "s3")
+	}
+	if len(types) > 1 {
+		return fmt.Errorf("only one artifact repository may be configured, but got %s", strings.Join(types, ", "))
+	}
+	return nil
+}
+
 // ToArtifactLocation returns the artifact location set with default template key:
 // key = `{{workflow.name}}/{{pod.name}}`
 func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
@@ -117,6 +152,22 @@ func (r *GCSArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
 	l.GCS = &GCSArtifact{GCSBucket: r.GCSBucket, Key: k}
 }
 
+// AzureArtifactRepository defines the controller configuration for an Azure Blob Storage artifact repository
+type AzureArtifactRepository struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=blobContainer"`
+
+	// BlobNameFormat is defines the format of how to store blob names. Can reference workflow variables
+	BlobNameFormat string `json:"blobNameFormat,omitempty" protobuf:"bytes,2,opt,name=blobNameFormat"`
+}
+
+func (r *AzureArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
+	k := r.BlobNameFormat
+	if k == "" {
+		k = DefaultArchivePattern
+	}
+	l.Azure = &AzureArtifact{AzureBlobContainer: r.AzureBlobContainer, Blob: k}
+}
+
 // ArtifactoryArtifactRepository defines the controller configuration for an artifactory artifact repository
 type ArtifactoryArtifactRepository struct {
 	ArtifactoryAuth `json:",inline" protobuf:"bytes,1,opt,name=artifactoryAuth"`
@@ -27,6 +27,14 @@ func TestArtifactRepository(t *testing.T) {
 			assert.Equal(t, "http://my-repo/{{workflow.name}}/{{pod.name}}", l.Artifactory.URL)
 		}
 	})
+	t.Run("Azure", func(t *testing.T) {
+		r := &ArtifactRepository{Azure: &AzureArtifactRepository{BlobNameFormat: "my-blob"}}
+		assert.IsType(t, &AzureArtifactRepository{}, r.Get())
+		l := r.ToArtifactLocation()
+		if assert.NotNil(t, l.Azure) {
+			assert.Equal(t, "my-blob", l.Azure.Blob)
+		}
+	})
 	t.Run("GCS", func(t *testing.T) {
 		r := &ArtifactRepository{GCS: &GCSArtifactRepository{}}
 		assert.IsType(t, &GCSArtifactRepository{}, r.Get())
@@ -66,3 +74,18 @@ func TestArtifactRepository_IsArchiveLogs(t *testing.T) {
 	assert.False(t, (&ArtifactRepository{ArchiveLogs: pointer.BoolPtr(false)}).IsArchiveLogs())
 	assert.True(t, (&ArtifactRepository{ArchiveLogs: pointer.BoolPtr(true)}).IsArchiveLogs())
 }
+
+func TestArtifactRepository_Validate(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var r *ArtifactRepository
+		assert.NoError(t, r.Validate())
+	})
+	t.Run("One", func(t *testing.T) {
+		r := &ArtifactRepository{GCS: &GCSArtifactRepository{}}
+		assert.NoError(t, r.Validate())
+	})
+	t.Run("Many", func(t *testing.T) {
+		r := &ArtifactRepository{Azure: &AzureArtifactRepository{}, GCS: &GCSArtifactRepository{}, S3: &S3ArtifactRepository{}}
+		assert.EqualError(t, r.Validate(), "only one artifact repository may be configured, but got azure, gcs, s3")
+	})
+}
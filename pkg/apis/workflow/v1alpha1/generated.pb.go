@@ -14,6 +14,7 @@ import (
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/policy/v1beta1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -148,10 +149,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
+func (*ArtifactGC) ProtoMessage() {}
+func (*ArtifactGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{4}
+}
+func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGC.Merge(m, src)
+}
+func (m *ArtifactGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +208,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +236,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +264,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +292,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +320,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +348,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +376,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,10 +401,94 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{13}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
+func (*AzureArtifactRepository) ProtoMessage() {}
+func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{14}
+}
+func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifactRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifactRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifactRepository.Merge(m, src)
+}
+func (m *AzureArtifactRepository) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifactRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifactRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{15}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,10 +513,38 @@ func (m *Backoff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Backoff proto.InternalMessageInfo
 
+func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
+func (*BasicAuth) ProtoMessage() {}
+func (*BasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{17}
+}
+func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasicAuth.Merge(m, src)
+}
+func (m *BasicAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
+
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +572,7 @@ var xxx_messageInfo_Cache proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +600,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +628,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +656,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +684,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +712,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +740,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +768,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +796,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +824,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +852,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +880,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +908,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +936,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +964,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +992,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1020,7 @@ var xxx_messageInfo_DataSource proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1048,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1076,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1104,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1132,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1160,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1188,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1216,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1244,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1272,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{44}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1300,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1328,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1356,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,10 +1381,38 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
+func (*HTTPAuth) ProtoMessage() {}
+func (*HTTPAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{48}
+}
+func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPAuth.Merge(m, src)
+}
+func (m *HTTPAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
+
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1440,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1468,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1496,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1524,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1552,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1580,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1608,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1636,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1664,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1692,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1720,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1748,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1776,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1804,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1832,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1860,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1888,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1916,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1944,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1972,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2000,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2028,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2056,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2084,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2112,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2140,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1996,10 +2165,66 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParameterSchema) Reset()      { *m = ParameterSchema{} }
+func (*ParameterSchema) ProtoMessage() {}
+func (*ParameterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{76}
+}
+func (m *ParameterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchema.Merge(m, src)
+}
+func (m *ParameterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchema proto.InternalMessageInfo
+
+func (m *ParametersSchema) Reset()      { *m = ParametersSchema{} }
+func (*ParametersSchema) ProtoMessage() {}
+func (*ParametersSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{77}
+}
+func (m *ParametersSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParametersSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParametersSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParametersSchema.Merge(m, src)
+}
+func (m *ParametersSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParametersSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParametersSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParametersSchema proto.InternalMessageInfo
+
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2252,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2280,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2308,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2336,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2364,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2392,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2420,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2448,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2476,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2504,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2532,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2560,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2588,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2616,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2644,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2672,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2700,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2728,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2756,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2784,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2812,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2840,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +2868,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2896,7 @@ var xxx_messageInfo_Task proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2924,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +2952,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +2980,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3008,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3036,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3064,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3092,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3120,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3148,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3176,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3204,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3232,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3260,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3288,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3316,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3344,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3372,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3400,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3428,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3456,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3484,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3512,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3540,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3345,6 +3570,7 @@ func init() {
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
 	proto.RegisterType((*ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepository")
@@ -3353,7 +3579,11 @@ func init() {
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifactRepository")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifactRepository")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
+	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
@@ -3385,6 +3615,7 @@ func init() {
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
 	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPAuth")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
@@ -3415,6 +3646,9 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema")
+	proto.RegisterType((*ParametersSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParametersSchema")
+	proto.RegisterMapType((map[string]ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParametersSchema.PropertiesEntry")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
@@ -3477,552 +3711,584 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 8713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xd9,
-	0x75, 0xd8, 0x56, 0x93, 0x4d, 0x76, 0x1f, 0x92, 0x43, 0xce, 0x9d, 0x57, 0x2f, 0x77, 0x66, 0x38,
-	0xae, 0xf5, 0x4e, 0x76, 0x9c, 0x15, 0xe9, 0x9d, 0xd1, 0x26, 0x1b, 0x09, 0x91, 0xc5, 0x26, 0x87,
-	0xe4, 0x2c, 0x9f, 0x7b, 0x9b, 0x33, 0x93, 0x7d, 0x44, 0x56, 0xb1, 0xfb, 0xb2, 0xbb, 0x96, 0xdd,
-	0x55, 0xbd, 0x55, 0xd5, 0x7c, 0x68, 0x77, 0x25, 0x65, 0x1d, 0x5b, 0xda, 0x58, 0x8e, 0xf3, 0x50,
-	0x6c, 0xd9, 0x49, 0x00, 0xc1, 0x89, 0x62, 0xc3, 0x31, 0x02, 0x18, 0xc8, 0x97, 0xfd, 0x1b, 0x18,
-	0x0a, 0xf2, 0x11, 0x07, 0x56, 0x62, 0x01, 0x71, 0xa8, 0x88, 0x79, 0x20, 0x48, 0xe0, 0x7c, 0x04,
-	0x91, 0x6c, 0x30, 0xfe, 0x08, 0xee, 0xb3, 0xee, 0xad, 0xae, 0xe6, 0x90, 0x33, 0x45, 0xce, 0x22,
-	0xca, 0x5f, 0xf7, 0xb9, 0xe7, 0x9e, 0x73, 0x9f, 0xe7, 0x9e, 0x7b, 0xce, 0xb9, 0xa7, 0x60, 0xad,
-	0xee, 0x46, 0x8d, 0xce, 0xc6, 0x64, 0xd5, 0x6f, 0x4d, 0x39, 0x41, 0xdd, 0x6f, 0x07, 0xfe, 0x3b,
-	0xec, 0xc7, 0x27, 0x76, 0xfc, 0x60, 0x6b, 0xb3, 0xe9, 0xef, 0x84, 0x53, 0xdb, 0x77, 0xa6, 0xda,
-	0x5b, 0xf5, 0x29, 0xa7, 0xed, 0x86, 0x53, 0x12, 0x3a, 0xb5, 0xfd, 0xb2, 0xd3, 0x6c, 0x37, 0x9c,
-	0x97, 0xa7, 0xea, 0xc4, 0x23, 0x81, 0x13, 0x91, 0xda, 0x64, 0x3b, 0xf0, 0x23, 0x1f, 0x7d, 0x36,
-	0xa6, 0x38, 0x29, 0x29, 0xb2, 0x1f, 0x3f, 0xad, 0x28, 0x4e, 0x6e, 0xdf, 0x99, 0x6c, 0x6f, 0xd5,
-	0x27, 0x29, 0xc5, 0x49, 0x09, 0x9d, 0x94, 0x14, 0xc7, 0x3f, 0xa1, 0xb5, 0xa9, 0xee, 0xd7, 0xfd,
-	0x29, 0x46, 0x78, 0xa3, 0xb3, 0xc9, 0xfe, 0xb1, 0x3f, 0xec, 0x17, 0x67, 0x38, 0x6e, 0x6f, 0xbd,
-	0x1a, 0x4e, 0xba, 0x3e, 0x6d, 0xdf, 0x54, 0xd5, 0x0f, 0xc8, 0xd4, 0x76, 0x57, 0xa3, 0xc6, 0x6f,
-	0x69, 0x38, 0x6d, 0xbf, 0xe9, 0x56, 0xf7, 0xa6, 0xb6, 0x5f, 0xde, 0x20, 0x51, 0x77, 0xfb, 0xc7,
-	0x3f, 0x19, 0xa3, 0xb6, 0x9c, 0x6a, 0xc3, 0xf5, 0x48, 0xb0, 0x17, 0xf7, 0xbf, 0x45, 0x22, 0x27,
-	0x8d, 0xc1, 0x54, 0xaf, 0x5a, 0x41, 0xc7, 0x8b, 0xdc, 0x16, 0xe9, 0xaa, 0xf0, 0x17, 0x1e, 0x55,
-	0x21, 0xac, 0x36, 0x48, 0xcb, 0xe9, 0xaa, 0x77, 0xa7, 0x57, 0xbd, 0x4e, 0xe4, 0x36, 0xa7, 0x5c,
-	0x2f, 0x0a, 0xa3, 0x20, 0x59, 0xc9, 0xbe, 0x0b, 0x03, 0xd3, 0x2d, 0xbf, 0xe3, 0x45, 0xe8, 0xd3,
-	0x90, 0xdf, 0x76, 0x9a, 0x1d, 0x52, 0xb2, 0x6e, 0x58, 0x2f, 0x16, 0xcb, 0x2f, 0x7c, 0x7b, 0x7f,
-	0xe2, 0x99, 0x83, 0xfd, 0x89, 0xfc, 0x03, 0x0a, 0x3c, 0xdc, 0x9f, 0xb8, 0x48, 0xbc, 0xaa, 0x5f,
-	0x73, 0xbd, 0xfa, 0xd4, 0x3b, 0xa1, 0xef, 0x4d, 0xae, 0x74, 0x5a, 0x1b, 0x24, 0xc0, 0xbc, 0x8e,
-	0xfd, 0x07, 0x39, 0x18, 0x9d, 0x0e, 0xaa, 0x0d, 0x77, 0x9b, 0x54, 0x22, 0x4a, 0xbf, 0xbe, 0x87,
-	0x1a, 0xd0, 0x17, 0x39, 0x01, 0x23, 0x37, 0x74, 0x7b, 0x79, 0xf2, 0x49, 0x27, 0x7f, 0x72, 0xdd,
-	0x09, 0x24, 0xed, 0xf2, 0xe0, 0xc1, 0xfe, 0x44, 0xdf, 0xba, 0x13, 0x60, 0xca, 0x02, 0x35, 0xa1,
-	0xdf, 0xf3, 0x3d, 0x52, 0xca, 0x31, 0x56, 0x2b, 0x4f, 0xce, 0x6a, 0xc5, 0xf7, 0x54, 0x3f, 0xca,
-	0x85, 0x83, 0xfd, 0x89, 0x7e, 0x0a, 0xc1, 0x8c, 0x0b, 0xed, 0xd7, 0x17, 0xdc, 0x76, 0xa9, 0x2f,
-	0xab, 0x7e, 0xbd, 0xe9, 0xb6, 0xcd, 0x7e, 0xbd, 0xe9, 0xb6, 0x31, 0x65, 0x61, 0x7f, 0x94, 0x83,
-	0xe2, 0x74, 0x50, 0xef, 0xb4, 0x88, 0x17, 0x85, 0xe8, 0x4b, 0x00, 0x6d, 0x27, 0x70, 0x5a, 0x24,
-	0x22, 0x41, 0x58, 0xb2, 0x6e, 0xf4, 0xbd, 0x38, 0x74, 0x7b, 0xf1, 0xc9, 0xd9, 0xaf, 0x49, 0x9a,
-	0x65, 0x24, 0xa6, 0x1c, 0x14, 0x28, 0xc4, 0x1a, 0x4b, 0xf4, 0x1e, 0x14, 0x9d, 0x20, 0x72, 0x37,
-	0x9d, 0x6a, 0x14, 0x96, 0x72, 0x8c, 0xff, 0x6b, 0x4f, 0xce, 0x7f, 0x5a, 0x90, 0x2c, 0x9f, 0x17,
-	0xec, 0x8b, 0x12, 0x12, 0xe2, 0x98, 0x9f, 0xfd, 0xeb, 0x79, 0x28, 0xc8, 0x02, 0x74, 0x03, 0xfa,
-	0x3d, 0xa7, 0x25, 0x97, 0xea, 0xb0, 0xa8, 0xd8, 0xbf, 0xe2, 0xb4, 0xe8, 0x24, 0x39, 0x2d, 0x42,
-	0x31, 0xda, 0x4e, 0xd4, 0x60, 0x4b, 0x42, 0xc3, 0x58, 0x73, 0xa2, 0x06, 0x66, 0x25, 0xe8, 0x2a,
-	0xf4, 0xb7, 0xfc, 0x1a, 0x61, 0xf3, 0x98, 0xe7, 0x93, 0xbc, 0xec, 0xd7, 0x08, 0x66, 0x50, 0x5a,
-	0x7f, 0x33, 0xf0, 0x5b, 0xa5, 0x7e, 0xb3, 0xfe, 0x5c, 0xe0, 0xb7, 0x30, 0x2b, 0x41, 0xdf, 0xb0,
-	0x60, 0x4c, 0x36, 0x6f, 0xc9, 0xaf, 0x3a, 0x91, 0xeb, 0x7b, 0xa5, 0x3c, 0x5b, 0x14, 0x38, 0xbb,
-	0x51, 0x91, 0x94, 0xcb, 0x25, 0xd1, 0x84, 0xb1, 0x64, 0x09, 0xee, 0x6a, 0x05, 0xba, 0x0d, 0x50,
-	0x6f, 0xfa, 0x1b, 0x4e, 0x93, 0x0e, 0x48, 0x69, 0x80, 0x75, 0x41, 0x4d, 0xee, 0xbc, 0x2a, 0xc1,
-	0x1a, 0x16, 0xda, 0x85, 0x41, 0x87, 0x6f, 0xe0, 0xd2, 0x20, 0xeb, 0xc4, 0xeb, 0x59, 0x74, 0xc2,
-	0x90, 0x08, 0xe5, 0xa1, 0x83, 0xfd, 0x89, 0x41, 0x01, 0xc4, 0x92, 0x1d, 0x7a, 0x09, 0x0a, 0x7e,
-	0x9b, 0xb6, 0xdb, 0x69, 0x96, 0x0a, 0x37, 0xac, 0x17, 0x0b, 0xe5, 0x31, 0xd1, 0xd6, 0xc2, 0xaa,
-	0x80, 0x63, 0x85, 0x81, 0x6e, 0xc1, 0x60, 0xd8, 0xd9, 0xa0, 0xf3, 0x58, 0x2a, 0xb2, 0x8e, 0x8d,
-	0x0a, 0xe4, 0xc1, 0x0a, 0x07, 0x63, 0x59, 0x8e, 0x5e, 0x81, 0xa1, 0x80, 0x54, 0x3b, 0x41, 0x48,
-	0xe8, 0xc4, 0x96, 0x80, 0xd1, 0xbe, 0x20, 0xd0, 0x87, 0x70, 0x5c, 0x84, 0x75, 0x3c, 0xf4, 0x19,
-	0x38, 0x47, 0x27, 0xf8, 0xee, 0x6e, 0x3b, 0x20, 0x61, 0x48, 0x67, 0x75, 0x88, 0x31, 0xba, 0x2c,
-	0x6a, 0x9e, 0x9b, 0x33, 0x4a, 0x71, 0x02, 0xdb, 0xfe, 0x9d, 0x41, 0xe8, 0x9a, 0x24, 0xf4, 0x32,
-	0x0c, 0x89, 0xfe, 0x2e, 0xf9, 0xf5, 0x90, 0x2d, 0xdc, 0x42, 0x79, 0x94, 0xb6, 0x63, 0x3a, 0x06,
-	0x63, 0x1d, 0x07, 0xd5, 0x20, 0x17, 0xde, 0x11, 0x32, 0x6d, 0xe9, 0xc9, 0x27, 0xa3, 0x72, 0x47,
-	0xed, 0xb4, 0x81, 0x83, 0xfd, 0x89, 0x5c, 0xe5, 0x0e, 0xce, 0x85, 0x77, 0xa8, 0x34, 0xab, 0xbb,
-	0x51, 0x76, 0xd2, 0x6c, 0xde, 0x8d, 0x14, 0x1f, 0x26, 0xcd, 0xe6, 0xdd, 0x08, 0x53, 0x16, 0x54,
-	0x4a, 0x37, 0xa2, 0xa8, 0xcd, 0xb6, 0x54, 0x26, 0x52, 0x7a, 0x61, 0x7d, 0x7d, 0x4d, 0xf1, 0x62,
-	0x1b, 0x98, 0x42, 0x30, 0xe3, 0x82, 0xbe, 0x6a, 0xd1, 0x11, 0xe7, 0x85, 0x7e, 0xb0, 0x27, 0x76,
-	0xe6, 0xfd, 0xec, 0x76, 0xa6, 0x1f, 0xec, 0x29, 0xe6, 0x62, 0x22, 0x55, 0x01, 0xd6, 0x59, 0xb3,
-	0x8e, 0xd7, 0x36, 0x43, 0xb6, 0x11, 0xb3, 0xe9, 0xf8, 0xec, 0x5c, 0x25, 0xd1, 0xf1, 0xd9, 0xb9,
-	0x0a, 0x66, 0x5c, 0xe8, 0x84, 0x06, 0xce, 0x8e, 0xd8, 0xc4, 0x19, 0x4c, 0x28, 0x76, 0x76, 0xcc,
-	0x09, 0xc5, 0xce, 0x0e, 0xa6, 0x2c, 0x28, 0x27, 0x3f, 0x0c, 0xd9, 0x9e, 0xcd, 0x84, 0xd3, 0x6a,
-	0xa5, 0x62, 0x72, 0x5a, 0xad, 0x54, 0x30, 0x65, 0xc1, 0x16, 0x69, 0x35, 0x64, 0x1b, 0x3e, 0x9b,
-	0x45, 0x3a, 0x93, 0xe0, 0x34, 0x3f, 0x53, 0xc1, 0x94, 0x85, 0xfd, 0x91, 0x05, 0x23, 0xb2, 0x88,
-	0x0a, 0x91, 0x10, 0xed, 0x42, 0x41, 0x4e, 0xa6, 0xd0, 0x65, 0xb2, 0x3c, 0xf4, 0x94, 0xa8, 0x93,
-	0x10, 0xac, 0xb8, 0xd9, 0xbf, 0x95, 0x07, 0xa4, 0xc0, 0xa4, 0xed, 0x87, 0x2e, 0x5b, 0x4e, 0x8f,
-	0x21, 0x4a, 0x3c, 0x4d, 0x94, 0x3c, 0xc8, 0x52, 0x94, 0xc4, 0xcd, 0x32, 0x84, 0xca, 0xdf, 0x49,
-	0x6c, 0x3e, 0x2e, 0x5d, 0x7e, 0xfa, 0x54, 0x36, 0x9f, 0xd6, 0x84, 0xa3, 0xb7, 0xe1, 0xb6, 0xd8,
-	0x86, 0x5c, 0xfe, 0xfc, 0x95, 0x6c, 0xb7, 0xa1, 0xd6, 0x8a, 0xe4, 0x86, 0x0c, 0xf8, 0x36, 0xe1,
-	0x02, 0xe8, 0x61, 0xa6, 0xdb, 0x44, 0xe3, 0x6a, 0x6e, 0x98, 0x80, 0x6f, 0x98, 0x81, 0xac, 0x78,
-	0x6a, 0x1b, 0x26, 0xc9, 0x53, 0x6d, 0x9d, 0x77, 0xe1, 0x52, 0x37, 0x0e, 0x26, 0x9b, 0x68, 0x0a,
-	0x8a, 0x55, 0xdf, 0xdb, 0x74, 0xeb, 0xcb, 0x4e, 0x5b, 0xa8, 0x6c, 0x4a, 0xd7, 0x9b, 0x91, 0x05,
-	0x38, 0xc6, 0x41, 0xd7, 0xa0, 0x6f, 0x8b, 0xec, 0x09, 0xdd, 0x6d, 0x48, 0xa0, 0xf6, 0x2d, 0x92,
-	0x3d, 0x4c, 0xe1, 0x9f, 0x2a, 0x7c, 0xe3, 0x9b, 0x13, 0xcf, 0x7c, 0xf9, 0x8f, 0x6e, 0x3c, 0x63,
-	0xff, 0x9b, 0x3e, 0x78, 0x2e, 0x95, 0x67, 0x25, 0x72, 0xa2, 0x4e, 0x88, 0x7e, 0xcb, 0x82, 0x4b,
-	0x4e, 0x5a, 0xb9, 0xd8, 0xc9, 0x0f, 0xb3, 0x5b, 0x91, 0x06, 0xf9, 0xf2, 0x35, 0xd1, 0xe8, 0xf4,
-	0x11, 0xc1, 0xe9, 0x8d, 0xa2, 0x03, 0x45, 0x95, 0xd7, 0xb0, 0xed, 0x54, 0x89, 0xe8, 0xbd, 0x1a,
-	0xa8, 0x15, 0x59, 0x80, 0x63, 0x1c, 0xaa, 0x0c, 0xd5, 0xc8, 0xa6, 0xd3, 0x69, 0xf2, 0x03, 0xbc,
-	0x10, 0x2b, 0x43, 0xb3, 0x1c, 0x8c, 0x65, 0x39, 0xfa, 0x07, 0x16, 0xa0, 0x6e, 0xae, 0x62, 0x33,
-	0xac, 0x9f, 0xc6, 0x38, 0x94, 0x2f, 0x1f, 0xec, 0x4f, 0xa4, 0x08, 0x30, 0x9c, 0xd2, 0x0e, 0x6d,
-	0x4e, 0xff, 0x95, 0x05, 0x17, 0x52, 0xb6, 0x39, 0x5d, 0x14, 0x9d, 0xa0, 0x29, 0xd6, 0x8f, 0x5a,
-	0x14, 0xf7, 0xf1, 0x12, 0xa6, 0x70, 0xf4, 0x75, 0x0b, 0x46, 0xb5, 0xdd, 0x3e, 0xdd, 0x11, 0xca,
-	0x7f, 0x46, 0x8a, 0xac, 0x41, 0xb8, 0x7c, 0x45, 0xb0, 0x1f, 0x4d, 0x14, 0xe0, 0x64, 0x13, 0xec,
-	0xef, 0x5b, 0x70, 0xed, 0x48, 0xa1, 0x95, 0xda, 0x70, 0xeb, 0xa9, 0x37, 0x9c, 0x2e, 0xad, 0x80,
-	0xb4, 0xfd, 0xfb, 0x78, 0x49, 0xac, 0x44, 0xb5, 0xb4, 0x30, 0x07, 0x63, 0x59, 0x6e, 0xff, 0xa1,
-	0x05, 0x49, 0x7a, 0xc8, 0x81, 0x73, 0x9d, 0x90, 0x04, 0x74, 0xa9, 0x56, 0x48, 0x35, 0x20, 0xf2,
-	0xec, 0x7c, 0x61, 0x92, 0x5b, 0x29, 0x68, 0x83, 0x27, 0xab, 0x7e, 0x40, 0x26, 0xb7, 0x5f, 0x9e,
-	0xe4, 0x18, 0x8b, 0x64, 0xaf, 0x42, 0x9a, 0x84, 0xd2, 0x28, 0x23, 0xaa, 0x67, 0xdf, 0x37, 0x08,
-	0xe0, 0x04, 0x41, 0xca, 0xa2, 0xed, 0x84, 0xe1, 0x8e, 0x1f, 0xd4, 0x04, 0x8b, 0xdc, 0x89, 0x59,
-	0xac, 0x19, 0x04, 0x70, 0x82, 0xa0, 0xfd, 0x2f, 0x2c, 0x18, 0x2c, 0x3b, 0xd5, 0x2d, 0x7f, 0x73,
-	0x93, 0x5e, 0x53, 0x6a, 0x9d, 0x80, 0x5f, 0xf3, 0xf8, 0x22, 0x54, 0x67, 0xf7, 0xac, 0x80, 0x63,
-	0x85, 0x81, 0xd6, 0x61, 0x80, 0x0f, 0x87, 0x68, 0xd4, 0x4f, 0x6a, 0x8d, 0x52, 0xd6, 0x19, 0x36,
-	0x73, 0x9d, 0xc8, 0x6d, 0x4e, 0x72, 0xeb, 0xcc, 0xe4, 0x3d, 0x2f, 0x5a, 0x0d, 0x2a, 0x51, 0xe0,
-	0x7a, 0xf5, 0x32, 0x1c, 0xec, 0x4f, 0x0c, 0xcc, 0x31, 0x1a, 0x58, 0xd0, 0xa2, 0x37, 0x9a, 0x96,
-	0xb3, 0x2b, 0xd9, 0xb1, 0x3d, 0x5f, 0x8c, 0x6f, 0x34, 0xcb, 0x71, 0x11, 0xd6, 0xf1, 0xec, 0xcf,
-	0x41, 0x7e, 0xc6, 0xa9, 0x36, 0x08, 0xba, 0x9f, 0x94, 0xc4, 0x43, 0xb7, 0x5f, 0x4c, 0x1b, 0x2d,
-	0x25, 0x95, 0xf5, 0x01, 0x1b, 0xe9, 0x25, 0xaf, 0xed, 0x1f, 0x58, 0x70, 0x65, 0xa6, 0xd9, 0x09,
-	0x23, 0x12, 0x3c, 0x14, 0x4b, 0x70, 0x9d, 0xb4, 0xda, 0x4d, 0x27, 0x22, 0xe8, 0xf3, 0x50, 0x68,
-	0x91, 0xc8, 0xa9, 0x39, 0x91, 0x23, 0x38, 0xf6, 0x1e, 0x0a, 0xb6, 0x88, 0x29, 0x36, 0x6d, 0xc3,
-	0xea, 0xc6, 0x3b, 0xa4, 0x1a, 0x2d, 0x93, 0xc8, 0x89, 0xef, 0xae, 0x31, 0x0c, 0x2b, 0xaa, 0x68,
-	0x17, 0xfa, 0xc3, 0x36, 0xa9, 0x66, 0xa7, 0xde, 0x24, 0xfb, 0x50, 0x69, 0x93, 0x6a, 0x6c, 0x02,
-	0xa0, 0xff, 0x30, 0xe3, 0x68, 0xff, 0x1f, 0x0b, 0x9e, 0xeb, 0xd1, 0xef, 0x25, 0x37, 0x8c, 0xd0,
-	0xdb, 0x5d, 0x7d, 0x9f, 0x3c, 0x5e, 0xdf, 0x69, 0x6d, 0xd6, 0x73, 0xb5, 0xc4, 0x24, 0x44, 0xeb,
-	0xf7, 0x17, 0x21, 0xef, 0x46, 0xa4, 0x25, 0x4d, 0x31, 0x6f, 0x3c, 0x79, 0xc7, 0x7b, 0xf4, 0xa5,
-	0x3c, 0x22, 0x6d, 0x81, 0xf7, 0x28, 0x3f, 0xcc, 0xd9, 0xda, 0xff, 0xd2, 0x02, 0xba, 0x1c, 0x6a,
-	0xae, 0xb8, 0xe0, 0xf6, 0x47, 0x7b, 0x6d, 0x69, 0x92, 0x91, 0xe7, 0x5f, 0xff, 0xfa, 0x5e, 0x9b,
-	0x1c, 0xee, 0x4f, 0x8c, 0x28, 0x44, 0x0a, 0xc0, 0x0c, 0x15, 0x7d, 0x0e, 0x06, 0x42, 0x76, 0x4e,
-	0x0b, 0x09, 0x33, 0x27, 0x2a, 0x0d, 0xf0, 0xd3, 0xfb, 0x70, 0x7f, 0xe2, 0x58, 0x16, 0xd7, 0x49,
-	0x45, 0x9b, 0xd7, 0xc3, 0x82, 0x2a, 0x15, 0x61, 0x2d, 0x12, 0x86, 0x4e, 0x9d, 0x88, 0x9d, 0xa2,
-	0x44, 0xd8, 0x32, 0x07, 0x63, 0x59, 0x6e, 0xff, 0x3d, 0x0b, 0x68, 0x13, 0x23, 0x87, 0xb2, 0x58,
-	0xf1, 0x6b, 0x04, 0xad, 0xb0, 0xad, 0xc2, 0x01, 0x62, 0xf2, 0xae, 0xf5, 0xd8, 0x2a, 0x1c, 0xc9,
-	0xd0, 0x69, 0x38, 0x08, 0xc7, 0x24, 0xd0, 0x27, 0x61, 0xb8, 0x46, 0xda, 0xc4, 0xab, 0x11, 0xaf,
-	0xea, 0x12, 0x3e, 0x69, 0xc5, 0xf2, 0xd8, 0xc1, 0xfe, 0xc4, 0xf0, 0xac, 0x06, 0xc7, 0x06, 0x96,
-	0xfd, 0x27, 0x16, 0x5c, 0x54, 0xe4, 0x2a, 0x24, 0x52, 0xdb, 0xea, 0x67, 0x2c, 0x00, 0x45, 0x9c,
-	0xea, 0xb4, 0x74, 0x09, 0xac, 0x66, 0xb0, 0x04, 0xf4, 0x41, 0x88, 0x37, 0x9e, 0x02, 0x87, 0x58,
-	0x63, 0x8b, 0xde, 0x80, 0xe1, 0x6d, 0xbf, 0xd9, 0x69, 0x91, 0x65, 0xbf, 0xe3, 0x45, 0x61, 0xa9,
-	0x8f, 0x35, 0x63, 0x22, 0x6d, 0x9c, 0x1e, 0xc4, 0x78, 0xe5, 0x8b, 0x82, 0xec, 0xb0, 0x06, 0x0c,
-	0xb1, 0x41, 0xca, 0x7e, 0x03, 0x18, 0x53, 0xd7, 0xeb, 0x90, 0x55, 0x0f, 0x3d, 0x0f, 0x79, 0x12,
-	0x04, 0x7e, 0x20, 0x6e, 0x3b, 0x6a, 0x41, 0xde, 0xa5, 0x40, 0xcc, 0xcb, 0xd0, 0x4d, 0x2a, 0x73,
-	0xdd, 0x26, 0xa9, 0xb1, 0xf5, 0x54, 0x28, 0x9f, 0x93, 0xeb, 0x69, 0x8e, 0x41, 0xb1, 0x28, 0xb5,
-	0x27, 0x61, 0x70, 0x86, 0x32, 0x21, 0x01, 0xa5, 0xab, 0x1b, 0xbd, 0x47, 0x0c, 0xa3, 0xb7, 0x34,
-	0x6e, 0xaf, 0xc3, 0xa5, 0x99, 0x80, 0x50, 0x41, 0x70, 0xa7, 0xdc, 0xa9, 0x6e, 0x91, 0x88, 0x9b,
-	0xa5, 0x42, 0xf4, 0x69, 0x18, 0xf1, 0x99, 0x44, 0x5a, 0xf2, 0xab, 0x5b, 0xae, 0x57, 0x17, 0x4a,
-	0xd8, 0x25, 0x41, 0x65, 0x64, 0x55, 0x2f, 0xc4, 0x26, 0xae, 0xfd, 0x9f, 0x73, 0x30, 0x3c, 0x13,
-	0xf8, 0x9e, 0xdc, 0x6d, 0x67, 0x20, 0x29, 0x23, 0x43, 0x52, 0x66, 0x60, 0xa5, 0xd4, 0xdb, 0xdf,
-	0x4b, 0x4a, 0xa2, 0xf7, 0xd5, 0x36, 0xef, 0xcb, 0x4a, 0xd9, 0x34, 0xf8, 0x32, 0xda, 0xf1, 0x64,
-	0x9b, 0x42, 0xc0, 0xfe, 0x2f, 0x16, 0x8c, 0xe9, 0xe8, 0x67, 0x20, 0x98, 0x43, 0x53, 0x30, 0xaf,
-	0x64, 0xdb, 0xdf, 0x1e, 0xd2, 0xf8, 0xa3, 0x01, 0xb3, 0x9f, 0x74, 0x02, 0xd0, 0x37, 0x2c, 0x18,
-	0xde, 0xd1, 0x00, 0xa2, 0xb3, 0x2b, 0xd9, 0x9d, 0x91, 0x6c, 0xd6, 0x7f, 0x5c, 0xee, 0x67, 0x1d,
-	0x7a, 0x98, 0xf8, 0x8f, 0x8d, 0x96, 0x50, 0x75, 0x2a, 0xac, 0x36, 0x48, 0xad, 0xd3, 0x94, 0x57,
-	0x1d, 0x35, 0xa4, 0x15, 0x01, 0xc7, 0x0a, 0x03, 0xbd, 0x0d, 0xe7, 0xab, 0xbe, 0x57, 0xed, 0x04,
-	0x01, 0xf1, 0xaa, 0x7b, 0x6b, 0xcc, 0x4f, 0x27, 0x84, 0xfa, 0xa4, 0xa8, 0x76, 0x7e, 0x26, 0x89,
-	0x70, 0x98, 0x06, 0xc4, 0xdd, 0x84, 0xb8, 0x4d, 0x39, 0xa4, 0x62, 0x97, 0xdd, 0x87, 0x0a, 0xba,
-	0x4d, 0x99, 0x81, 0xb1, 0x2c, 0x47, 0xf7, 0xe1, 0x4a, 0x18, 0x51, 0x5d, 0xd9, 0xab, 0xcf, 0x12,
-	0xa7, 0xd6, 0x74, 0x3d, 0xaa, 0x8e, 0xfa, 0x5e, 0x8d, 0x5f, 0xf0, 0xfb, 0xca, 0xcf, 0x1d, 0xec,
-	0x4f, 0x5c, 0xa9, 0xa4, 0xa3, 0xe0, 0x5e, 0x75, 0xd1, 0xe7, 0x60, 0x3c, 0xec, 0x54, 0xab, 0x24,
-	0x0c, 0x37, 0x3b, 0xcd, 0xd7, 0xfc, 0x8d, 0x70, 0xc1, 0x0d, 0xa9, 0x2e, 0xbd, 0xe4, 0xb6, 0xdc,
-	0x88, 0x5d, 0xe3, 0xf3, 0xe5, 0xeb, 0x07, 0xfb, 0x13, 0xe3, 0x95, 0x9e, 0x58, 0xf8, 0x08, 0x0a,
-	0x08, 0xc3, 0x65, 0x2e, 0xfc, 0xba, 0x68, 0x0f, 0x32, 0xda, 0xe3, 0x07, 0xfb, 0x13, 0x97, 0xe7,
-	0x52, 0x31, 0x70, 0x8f, 0x9a, 0x74, 0x06, 0x23, 0xb7, 0x45, 0xbe, 0xe0, 0x7b, 0x84, 0xd9, 0x00,
-	0xb5, 0x19, 0x5c, 0x17, 0x70, 0xac, 0x30, 0xd0, 0x3b, 0xf1, 0x4a, 0xa4, 0xdb, 0x45, 0xd8, 0xf2,
-	0x4e, 0x2e, 0xe1, 0x2e, 0x1e, 0xec, 0x4f, 0x8c, 0x3d, 0xd4, 0x28, 0xd1, 0x2d, 0x87, 0x0d, 0xda,
-	0xf6, 0x1f, 0xe4, 0x00, 0x75, 0x8b, 0x08, 0xb4, 0x08, 0x03, 0x4e, 0x35, 0x72, 0xb7, 0x89, 0x70,
-	0x9e, 0x3d, 0x9f, 0x76, 0x4e, 0x71, 0x56, 0x98, 0x6c, 0x12, 0xba, 0x42, 0x48, 0x2c, 0x57, 0xa6,
-	0x59, 0x55, 0x2c, 0x48, 0x20, 0x1f, 0xce, 0x37, 0x9d, 0x30, 0x92, 0x6b, 0xb5, 0x46, 0xbb, 0x2c,
-	0x04, 0xeb, 0x4f, 0x1c, 0xaf, 0x53, 0xb4, 0x46, 0xf9, 0x12, 0x5d, 0xb9, 0x4b, 0x49, 0x42, 0xb8,
-	0x9b, 0x36, 0xfa, 0x12, 0x3b, 0xf0, 0xb9, 0xa2, 0x23, 0x4f, 0xda, 0xc5, 0x4c, 0x0e, 0x7c, 0x4e,
-	0xd3, 0x38, 0xec, 0x05, 0x1b, 0xac, 0xb1, 0xb4, 0xff, 0xa8, 0x08, 0x83, 0xb3, 0xd3, 0xf3, 0xeb,
-	0x4e, 0xb8, 0x75, 0x0c, 0x07, 0x1c, 0x5d, 0x1d, 0x42, 0x59, 0x49, 0xee, 0x6f, 0xa9, 0xc4, 0x60,
-	0x85, 0x81, 0xde, 0x87, 0xa2, 0x23, 0x1d, 0x9d, 0xe2, 0x98, 0x58, 0xcc, 0xe2, 0xf6, 0x2b, 0x48,
-	0xea, 0xbe, 0x45, 0x01, 0xc2, 0x31, 0x43, 0xf4, 0x65, 0x0b, 0x86, 0x64, 0x53, 0x30, 0xd9, 0x14,
-	0x46, 0x91, 0x2c, 0x5c, 0xd6, 0x31, 0x51, 0x6e, 0x9c, 0xd4, 0x00, 0x58, 0x67, 0xd9, 0xa5, 0x1e,
-	0xe6, 0x8f, 0xa3, 0x1e, 0xa2, 0x1d, 0x28, 0xee, 0xb8, 0x51, 0x83, 0x1d, 0x04, 0xa5, 0x01, 0xb6,
-	0x24, 0xe6, 0x9e, 0xbc, 0xd5, 0x94, 0x5c, 0x3c, 0x62, 0x0f, 0x25, 0x03, 0x1c, 0xf3, 0x42, 0x53,
-	0x9c, 0x31, 0x73, 0x14, 0x33, 0x11, 0x52, 0x34, 0x2b, 0xb0, 0x02, 0x1c, 0xe3, 0xd0, 0x21, 0x1e,
-	0xa6, 0xff, 0x2a, 0xe4, 0xdd, 0x0e, 0xdd, 0x57, 0xc2, 0x6b, 0x90, 0x81, 0x29, 0x5d, 0x52, 0xe4,
-	0x83, 0xf5, 0x50, 0xe3, 0x81, 0x0d, 0x8e, 0x74, 0xcd, 0xee, 0x34, 0x88, 0x27, 0xdc, 0x86, 0x6a,
-	0xcd, 0x3e, 0x6c, 0x10, 0x0f, 0xb3, 0x12, 0xf4, 0x3e, 0xd7, 0xa9, 0xb9, 0xce, 0xc9, 0xfc, 0x85,
-	0x99, 0x78, 0xde, 0x62, 0x3d, 0xb6, 0x7c, 0x4e, 0x2a, 0xd3, 0xfc, 0x3f, 0xd6, 0xf8, 0x51, 0xf5,
-	0xd5, 0xf7, 0xee, 0xee, 0xba, 0x91, 0xf0, 0x37, 0x2a, 0xc9, 0xb3, 0xca, 0xa0, 0x58, 0x94, 0x72,
-	0xa3, 0x1f, 0x5d, 0x04, 0x61, 0x69, 0xd8, 0xbc, 0xd6, 0xf0, 0x95, 0x12, 0x62, 0x59, 0x8e, 0xfe,
-	0xa1, 0x05, 0xf9, 0x86, 0xef, 0x6f, 0x85, 0xa5, 0x11, 0xb6, 0x38, 0x32, 0x50, 0xbd, 0x84, 0x04,
-	0x98, 0x5c, 0xa0, 0x64, 0xef, 0x7a, 0x51, 0xb0, 0x57, 0x7e, 0x59, 0x2a, 0x24, 0x0c, 0x76, 0xb8,
-	0x3f, 0x71, 0x6e, 0xc9, 0xdd, 0x24, 0xd5, 0xbd, 0x6a, 0x93, 0x30, 0xc8, 0x87, 0xdf, 0xd3, 0x20,
-	0x77, 0xb7, 0x89, 0x17, 0x61, 0xde, 0xaa, 0xf1, 0x8f, 0x2c, 0x80, 0x98, 0x10, 0x1a, 0xe3, 0x76,
-	0x5f, 0x26, 0x54, 0x98, 0xa9, 0x17, 0x11, 0xa9, 0x9f, 0x73, 0xc9, 0x9a, 0xc1, 0x05, 0xc7, 0x68,
-	0x9a, 0xd0, 0xf0, 0x3f, 0x95, 0x7b, 0xd5, 0xb2, 0xff, 0xb5, 0x05, 0x43, 0xb4, 0x73, 0x52, 0x24,
-	0xdd, 0x84, 0x81, 0xc8, 0x09, 0xea, 0xc2, 0x72, 0xa5, 0x4d, 0xc7, 0x3a, 0x83, 0x62, 0x51, 0x8a,
-	0x3c, 0xc8, 0x47, 0x4e, 0xb8, 0x25, 0xb5, 0xbd, 0x7b, 0x99, 0x0d, 0x71, 0xac, 0xe8, 0xd1, 0x7f,
-	0x21, 0xe6, 0x6c, 0xd0, 0x8b, 0x50, 0xa0, 0x07, 0xf2, 0x9c, 0x13, 0x4a, 0xa3, 0xef, 0x30, 0x15,
-	0xaa, 0x73, 0x02, 0x86, 0x55, 0xa9, 0xfd, 0x77, 0x73, 0xd0, 0x3f, 0xcb, 0xf5, 0xfe, 0x81, 0xd0,
-	0xef, 0x04, 0x55, 0x22, 0xf4, 0xbf, 0x0c, 0xd6, 0x34, 0xa5, 0x5b, 0x61, 0x34, 0x35, 0xcd, 0x9b,
-	0xfd, 0xc7, 0x82, 0x17, 0xfa, 0xba, 0x05, 0xe7, 0xa2, 0xc0, 0xf1, 0xc2, 0x4d, 0x3f, 0x68, 0x71,
-	0x83, 0x55, 0x2e, 0xab, 0x55, 0xb8, 0x6e, 0xd0, 0xad, 0x44, 0xa4, 0x1d, 0xbb, 0xe7, 0xcd, 0x32,
-	0x9c, 0x68, 0x83, 0xfd, 0xcb, 0x16, 0x40, 0xdc, 0x7a, 0xf4, 0x55, 0x0b, 0x46, 0x1c, 0xdd, 0xe1,
-	0x27, 0xc6, 0x68, 0x35, 0x3b, 0xe3, 0x2b, 0x23, 0x5b, 0x3e, 0x4f, 0x6f, 0x84, 0x06, 0x08, 0x9b,
-	0x8c, 0xed, 0x57, 0x20, 0xcf, 0x76, 0x07, 0xd3, 0x8d, 0x85, 0xd5, 0x2d, 0x69, 0x6a, 0x94, 0xd6,
-	0x38, 0xac, 0x30, 0xec, 0xb7, 0xe1, 0xdc, 0xdd, 0x5d, 0x52, 0xed, 0x44, 0x7e, 0xc0, 0xad, 0x73,
-	0xe8, 0x35, 0x40, 0x21, 0x09, 0xb6, 0xdd, 0x2a, 0x99, 0xae, 0x56, 0xe9, 0x4d, 0x77, 0x25, 0x3e,
-	0xab, 0xc7, 0x05, 0x25, 0x54, 0xe9, 0xc2, 0xc0, 0x29, 0xb5, 0xec, 0xdf, 0xb4, 0x60, 0x48, 0xf3,
-	0xfe, 0xd0, 0x93, 0xba, 0x3e, 0x53, 0xe1, 0xf7, 0x60, 0x31, 0x54, 0x8b, 0x99, 0xf8, 0x97, 0x38,
-	0xc9, 0xf8, 0x18, 0x51, 0x20, 0x1c, 0x33, 0x7c, 0x84, 0x67, 0xc8, 0xfe, 0x3d, 0x0b, 0x2e, 0xa5,
-	0xba, 0xaa, 0x9e, 0x72, 0xb3, 0xa7, 0xa0, 0xb8, 0x45, 0xf6, 0xe6, 0xd8, 0x1a, 0x4c, 0x3a, 0x76,
-	0x16, 0x65, 0x01, 0x8e, 0x71, 0xec, 0xdf, 0xb6, 0x20, 0xa6, 0x44, 0x45, 0xd1, 0x46, 0xdc, 0x72,
-	0x4d, 0x14, 0x09, 0x4e, 0xa2, 0x14, 0xbd, 0x0f, 0x57, 0xcc, 0x19, 0x64, 0xe6, 0xdb, 0x93, 0x9b,
-	0xc6, 0xf9, 0x1d, 0x26, 0x9d, 0x12, 0xee, 0xc5, 0xc2, 0x7e, 0x00, 0xf9, 0x79, 0xa7, 0x53, 0x27,
-	0xc7, 0x32, 0xaa, 0x50, 0x31, 0x16, 0x10, 0xa7, 0x19, 0x49, 0xb5, 0x59, 0x88, 0x31, 0x2c, 0x60,
-	0x58, 0x95, 0xda, 0x3f, 0xe8, 0x87, 0x21, 0x2d, 0xaa, 0x84, 0x9e, 0xe3, 0x01, 0x69, 0xfb, 0x49,
-	0xdd, 0x93, 0x4e, 0x36, 0x66, 0x25, 0x74, 0xff, 0x04, 0x64, 0xdb, 0x0d, 0xb9, 0xc8, 0x31, 0xf6,
-	0x0f, 0x16, 0x70, 0xac, 0x30, 0xd0, 0x04, 0xe4, 0x6b, 0xa4, 0x1d, 0x35, 0x98, 0x34, 0xed, 0x2f,
-	0x17, 0x69, 0x53, 0x67, 0x29, 0x00, 0x73, 0x38, 0x45, 0xd8, 0x24, 0x51, 0xb5, 0xc1, 0xac, 0x6c,
-	0x45, 0x8e, 0x30, 0x47, 0x01, 0x98, 0xc3, 0x53, 0x9c, 0x1d, 0xf9, 0xd3, 0x77, 0x76, 0x0c, 0x64,
-	0xec, 0xec, 0x40, 0x6d, 0xb8, 0x10, 0x86, 0x8d, 0xb5, 0xc0, 0xdd, 0x76, 0x22, 0x12, 0xaf, 0x9c,
-	0xc1, 0x93, 0xf0, 0xb9, 0x72, 0xb0, 0x3f, 0x71, 0xa1, 0x52, 0x59, 0x48, 0x52, 0xc1, 0x69, 0xa4,
-	0x51, 0x05, 0x2e, 0xb9, 0x5e, 0x48, 0xaa, 0x9d, 0x80, 0xdc, 0xab, 0x7b, 0x7e, 0x40, 0x16, 0xfc,
-	0x90, 0x92, 0x13, 0x61, 0x60, 0xca, 0x89, 0x7a, 0x2f, 0x0d, 0x09, 0xa7, 0xd7, 0x45, 0xf3, 0x70,
-	0xbe, 0xe6, 0x86, 0xce, 0x46, 0x93, 0x54, 0x3a, 0x1b, 0x2d, 0x9f, 0x5e, 0xa0, 0x78, 0xe4, 0x48,
-	0xa1, 0xfc, 0xac, 0x34, 0x15, 0xcc, 0x26, 0x11, 0x70, 0x77, 0x1d, 0xfb, 0xbb, 0x16, 0x0c, 0xeb,
-	0x2e, 0x7e, 0xaa, 0xc3, 0x42, 0x63, 0x76, 0xae, 0xc2, 0xa5, 0x6c, 0x76, 0x67, 0xe9, 0x82, 0xa2,
-	0x19, 0xdf, 0xc1, 0x62, 0x18, 0xd6, 0x78, 0x1e, 0x23, 0xac, 0xf1, 0x79, 0xc8, 0x6f, 0xfa, 0xf4,
-	0xa8, 0xef, 0x33, 0x2d, 0xa5, 0x73, 0x14, 0x88, 0x79, 0x99, 0xfd, 0xbf, 0x2d, 0xb8, 0x9c, 0x1e,
-	0xbd, 0xf0, 0x71, 0xe8, 0xe4, 0x6d, 0x00, 0xda, 0x15, 0x43, 0x5c, 0x6a, 0xb1, 0xa9, 0xb2, 0x04,
-	0x6b, 0x58, 0xc7, 0xeb, 0xf6, 0x0f, 0xa9, 0xba, 0x19, 0xf3, 0xf9, 0x9a, 0x05, 0x23, 0x94, 0xed,
-	0x62, 0xb0, 0x61, 0xf4, 0x76, 0x35, 0x9b, 0xde, 0x2a, 0xb2, 0xb1, 0x41, 0xd8, 0x00, 0x63, 0x93,
-	0x39, 0xfa, 0xf3, 0x50, 0x74, 0x6a, 0xb5, 0x80, 0x84, 0xa1, 0x72, 0x0f, 0x30, 0x97, 0xdb, 0xb4,
-	0x04, 0xe2, 0xb8, 0x9c, 0x8a, 0xb8, 0x46, 0x6d, 0x33, 0xa4, 0x52, 0x43, 0xd8, 0xc1, 0x94, 0x88,
-	0xa3, 0x4c, 0x28, 0x1c, 0x2b, 0x0c, 0xfb, 0x17, 0xfa, 0xc1, 0xe4, 0x8d, 0x6a, 0x30, 0xba, 0x15,
-	0x6c, 0xcc, 0x30, 0xb7, 0xe0, 0xe3, 0x38, 0x68, 0x2f, 0x1c, 0xec, 0x4f, 0x8c, 0x2e, 0x9a, 0x14,
-	0x70, 0x92, 0xa4, 0xe0, 0xb2, 0x48, 0xf6, 0x22, 0x67, 0xe3, 0x71, 0x0e, 0x22, 0xc9, 0x45, 0xa7,
-	0x80, 0x93, 0x24, 0xd1, 0x2b, 0x30, 0xb4, 0x15, 0x6c, 0x48, 0x01, 0x9a, 0xf4, 0x8a, 0x2e, 0xc6,
-	0x45, 0x58, 0xc7, 0xa3, 0x43, 0xb8, 0x15, 0x6c, 0xd0, 0x03, 0x47, 0x86, 0xf9, 0xaa, 0x21, 0x5c,
-	0x14, 0x70, 0xac, 0x30, 0x50, 0x1b, 0xd0, 0x96, 0x1c, 0x3d, 0xe5, 0x04, 0x15, 0x72, 0xfe, 0xf8,
-	0x3e, 0x54, 0x16, 0x12, 0xb1, 0xd8, 0x45, 0x07, 0xa7, 0xd0, 0x46, 0x6f, 0xc0, 0x95, 0xad, 0x60,
-	0x43, 0x1c, 0xc3, 0x6b, 0x81, 0xeb, 0x55, 0xdd, 0xb6, 0x11, 0xd2, 0x3b, 0x21, 0x9a, 0x7b, 0x65,
-	0x31, 0x1d, 0x0d, 0xf7, 0xaa, 0x6f, 0x7f, 0x33, 0x07, 0x2c, 0x56, 0x92, 0x6a, 0x16, 0x2d, 0x12,
-	0x35, 0xfc, 0x5a, 0x52, 0xb3, 0x58, 0x66, 0x50, 0x2c, 0x4a, 0x65, 0xf0, 0x45, 0xae, 0x47, 0xf0,
-	0xc5, 0x0e, 0x0c, 0x36, 0x88, 0x53, 0x23, 0x81, 0x34, 0x4c, 0x2d, 0x65, 0x13, 0xdd, 0xb9, 0xc0,
-	0x88, 0xc6, 0x17, 0x5c, 0xfe, 0x3f, 0xc4, 0x92, 0x1b, 0xfa, 0x14, 0x9c, 0xa3, 0x3a, 0x82, 0xdf,
-	0x89, 0xa4, 0x15, 0xb6, 0x9f, 0x59, 0x61, 0xd9, 0x79, 0xb7, 0x6e, 0x94, 0xe0, 0x04, 0x26, 0xba,
-	0x0a, 0xfd, 0x1b, 0x7e, 0x8d, 0x47, 0x86, 0x0e, 0xf3, 0xa8, 0xad, 0xb2, 0x5f, 0xdb, 0xc3, 0x0c,
-	0x6a, 0xff, 0x1a, 0x95, 0xfe, 0x5a, 0x80, 0xe9, 0xa3, 0xe2, 0x4f, 0xc2, 0x78, 0x08, 0xf8, 0x2d,
-	0x67, 0x21, 0x83, 0x21, 0x78, 0x44, 0xf7, 0xed, 0xef, 0x50, 0x81, 0xa6, 0xc6, 0xe9, 0x18, 0x56,
-	0xb9, 0xe7, 0xf5, 0xfb, 0x74, 0x2f, 0xd5, 0xec, 0x4b, 0x50, 0x64, 0x3f, 0xe6, 0x02, 0xbf, 0x25,
-	0x8c, 0x71, 0x38, 0xcb, 0xf9, 0x14, 0xf7, 0x46, 0x26, 0xdc, 0x1e, 0x48, 0x46, 0x38, 0xe6, 0x69,
-	0xfb, 0x30, 0x96, 0xc4, 0x46, 0x6f, 0xc1, 0x70, 0x28, 0xe5, 0x43, 0x1c, 0xc0, 0x75, 0x4c, 0x39,
-	0xc2, 0x4c, 0x43, 0x15, 0xad, 0x3a, 0x36, 0x88, 0xd9, 0xab, 0x30, 0x90, 0xe9, 0x10, 0xda, 0xdf,
-	0xb2, 0xa0, 0xc8, 0x8c, 0xe5, 0xf5, 0xc0, 0x69, 0xc5, 0x55, 0xfa, 0x8e, 0x18, 0xf5, 0x10, 0x06,
-	0xb9, 0x1a, 0x2f, 0xbd, 0xb9, 0x19, 0x2c, 0x20, 0xfe, 0xb4, 0x27, 0x5e, 0x40, 0xfc, 0xbe, 0x10,
-	0x62, 0xc9, 0xc9, 0xfe, 0xb9, 0x1c, 0x0c, 0xdc, 0xf3, 0xda, 0x9d, 0x1f, 0xf9, 0xe7, 0x25, 0xcb,
-	0xd0, 0x7f, 0x2f, 0x22, 0x2d, 0xf3, 0x15, 0xd4, 0x70, 0xf9, 0x05, 0xfd, 0x05, 0x54, 0xc9, 0x7c,
-	0x01, 0x85, 0x9d, 0x1d, 0x19, 0x47, 0x20, 0xcc, 0x48, 0x71, 0x10, 0xdb, 0xef, 0x5a, 0x30, 0x62,
-	0x58, 0x9a, 0x0c, 0x7b, 0xb8, 0x75, 0x32, 0x7b, 0x78, 0xee, 0x8c, 0xed, 0xe1, 0x76, 0x13, 0xfa,
-	0x97, 0x5c, 0x6f, 0xeb, 0x78, 0x9b, 0x21, 0xac, 0xfa, 0xed, 0xae, 0xcd, 0x50, 0xa1, 0x40, 0xcc,
-	0xcb, 0xa4, 0xe4, 0xec, 0x4b, 0x97, 0x9c, 0xf6, 0x87, 0x16, 0x9c, 0x5f, 0x26, 0x2d, 0xdf, 0xfd,
-	0x82, 0x13, 0x07, 0x71, 0xd0, 0x4a, 0x0d, 0x37, 0x12, 0xfe, 0x7e, 0x55, 0x69, 0xc1, 0x8d, 0x30,
-	0x85, 0x3f, 0xc2, 0x10, 0xc0, 0x42, 0x4e, 0xa9, 0x1e, 0xb2, 0x12, 0x2b, 0x04, 0x71, 0x78, 0x86,
-	0x2c, 0xc0, 0x31, 0x8e, 0xfd, 0x3b, 0x16, 0x0c, 0xf2, 0x46, 0x10, 0x49, 0xdb, 0xea, 0x41, 0xbb,
-	0x01, 0x79, 0x56, 0x4f, 0xcc, 0xcb, 0x7c, 0x06, 0x06, 0x62, 0x4a, 0x8e, 0xdf, 0x2b, 0xd9, 0x4f,
-	0xcc, 0x19, 0xb0, 0xd3, 0xd9, 0xd9, 0x9d, 0x56, 0xf1, 0x2b, 0xf1, 0xe9, 0xcc, 0xa0, 0x58, 0x94,
-	0xda, 0xbf, 0xda, 0x07, 0x05, 0xe9, 0x0a, 0xe3, 0xb1, 0xd7, 0x9e, 0xe7, 0x47, 0x0e, 0xf7, 0x14,
-	0xf1, 0x9d, 0xfc, 0xd6, 0x93, 0xb7, 0x52, 0x72, 0x98, 0x9c, 0x8e, 0xa9, 0x73, 0x03, 0xb0, 0xd2,
-	0xb5, 0xb4, 0x12, 0xac, 0x37, 0x02, 0x7d, 0x11, 0x06, 0x9a, 0xce, 0x06, 0x69, 0xca, 0x8d, 0xfd,
-	0x20, 0xc3, 0xe6, 0x2c, 0x31, 0xc2, 0xbc, 0x25, 0x6a, 0x84, 0x38, 0x10, 0x0b, 0xae, 0xe3, 0x9f,
-	0x81, 0xb1, 0x64, 0xab, 0x53, 0xac, 0xcd, 0x17, 0x0d, 0xd1, 0xae, 0x19, 0x87, 0xc7, 0xff, 0x12,
-	0x0c, 0x69, 0x6c, 0x4e, 0x52, 0xd5, 0x7e, 0x1d, 0x86, 0x96, 0x49, 0x14, 0xb8, 0x55, 0x46, 0xe0,
-	0x51, 0x8b, 0xeb, 0x58, 0xa7, 0xcb, 0x57, 0xd8, 0x62, 0xa5, 0x34, 0x43, 0xf4, 0x3e, 0x40, 0x3b,
-	0xf0, 0xa9, 0x9a, 0x46, 0x3a, 0x72, 0xb2, 0x33, 0xd0, 0xbe, 0xd6, 0x14, 0x4d, 0xee, 0xb3, 0x88,
-	0xff, 0x63, 0x8d, 0x9f, 0x7d, 0x0b, 0xf2, 0xcb, 0x9d, 0x88, 0xec, 0x3e, 0x5a, 0x54, 0xd8, 0x6f,
-	0xc1, 0x30, 0x43, 0x5d, 0xf0, 0x9b, 0x54, 0x86, 0xd2, 0x9e, 0xb6, 0xe8, 0xff, 0xa4, 0x95, 0x88,
-	0x21, 0x61, 0x5e, 0x46, 0x77, 0x40, 0xc3, 0x6f, 0xd6, 0x48, 0x20, 0xc6, 0x43, 0xcd, 0xef, 0x02,
-	0x83, 0x62, 0x51, 0x6a, 0xff, 0x4c, 0x0e, 0x86, 0x58, 0x45, 0x21, 0x3d, 0xf6, 0x60, 0xb0, 0xc1,
-	0xf9, 0x88, 0x21, 0xc9, 0x20, 0xe4, 0x41, 0x6f, 0xbd, 0xa6, 0x93, 0x71, 0x00, 0x96, 0xfc, 0x28,
-	0xeb, 0x1d, 0xc7, 0x8d, 0x28, 0xeb, 0xdc, 0xe9, 0xb2, 0x7e, 0xc8, 0xd9, 0x60, 0xc9, 0xcf, 0xfe,
-	0xf7, 0x16, 0xc0, 0x8a, 0x5f, 0x23, 0x98, 0x84, 0x9d, 0x66, 0x84, 0x7e, 0x12, 0xf2, 0xed, 0x86,
-	0x13, 0x26, 0x2d, 0xbf, 0xf9, 0x35, 0x0a, 0x3c, 0xdc, 0x9f, 0x28, 0x52, 0x5c, 0xf6, 0x07, 0x73,
-	0x44, 0x3d, 0x62, 0x2e, 0x77, 0x74, 0xc4, 0x1c, 0x6a, 0xc3, 0xa0, 0xdf, 0x89, 0xa8, 0xe6, 0x20,
-	0x54, 0xc4, 0x0c, 0x1c, 0x1f, 0xab, 0x9c, 0x20, 0x7f, 0x27, 0x28, 0xfe, 0x60, 0xc9, 0xc6, 0xfe,
-	0xaf, 0xa3, 0xbc, 0x77, 0x62, 0x8a, 0xc7, 0x21, 0xe7, 0xca, 0x6b, 0x0b, 0x88, 0x66, 0xe6, 0xee,
-	0xcd, 0xe2, 0x9c, 0x5b, 0x53, 0xab, 0x31, 0xd7, 0xf3, 0xe0, 0x7a, 0x05, 0x86, 0x6a, 0x6e, 0xd8,
-	0x6e, 0x3a, 0x7b, 0x2b, 0x29, 0x77, 0xc6, 0xd9, 0xb8, 0x08, 0xeb, 0x78, 0xe8, 0x25, 0x11, 0xe5,
-	0xc8, 0xef, 0x8b, 0xa5, 0x44, 0x94, 0x63, 0x81, 0x36, 0x4f, 0x0b, 0x70, 0x7c, 0x15, 0x86, 0xe5,
-	0x89, 0xce, 0xb8, 0xe4, 0x59, 0x2d, 0x15, 0xfd, 0xb6, 0xae, 0x95, 0x61, 0x03, 0xb3, 0xcb, 0x23,
-	0x3d, 0x70, 0xf6, 0x1e, 0xe9, 0x4f, 0xc3, 0x88, 0xfc, 0xcb, 0x4e, 0xf3, 0xd2, 0x45, 0xd6, 0x7a,
-	0x65, 0xcb, 0x58, 0xd7, 0x0b, 0xb1, 0x89, 0x1b, 0x2f, 0xbd, 0xc1, 0xe3, 0x2e, 0xbd, 0xdb, 0x00,
-	0x1b, 0x7e, 0xc7, 0xab, 0x39, 0xc1, 0xde, 0xbd, 0x59, 0x11, 0x4f, 0xa2, 0x34, 0xc6, 0xb2, 0x2a,
-	0xc1, 0x1a, 0x96, 0xbe, 0x5c, 0x8b, 0x8f, 0x58, 0xae, 0x6f, 0x41, 0x91, 0xc5, 0xde, 0x90, 0xda,
-	0x74, 0x24, 0x3c, 0xbb, 0x27, 0x09, 0xd3, 0x50, 0xca, 0x43, 0x45, 0x12, 0xc1, 0x31, 0x3d, 0xf4,
-	0x39, 0x80, 0x4d, 0xd7, 0x73, 0xc3, 0x06, 0xa3, 0x3e, 0x74, 0x62, 0xea, 0xaa, 0x9f, 0x73, 0x8a,
-	0x0a, 0xd6, 0x28, 0xa2, 0xb7, 0xe1, 0x3c, 0x09, 0x23, 0xb7, 0xe5, 0x44, 0xa4, 0xa6, 0x82, 0xbf,
-	0x4b, 0xec, 0xa2, 0xab, 0xa2, 0x9f, 0xee, 0x26, 0x11, 0x0e, 0xd3, 0x80, 0xb8, 0x9b, 0x10, 0x7a,
-	0x15, 0x0a, 0xed, 0xc0, 0xaf, 0x07, 0x24, 0x0c, 0x4b, 0xe3, 0x6c, 0x18, 0xaf, 0x4a, 0xcd, 0x74,
-	0x4d, 0xc0, 0x0f, 0xb5, 0xdf, 0x58, 0x61, 0xa3, 0x3f, 0xb5, 0xe0, 0x7c, 0x40, 0xb8, 0xbb, 0x2f,
-	0x54, 0x0d, 0xbb, 0xc4, 0xa4, 0x5e, 0x35, 0x8b, 0x57, 0xf8, 0x72, 0xb3, 0x4f, 0xe2, 0x24, 0x17,
-	0x7e, 0xdc, 0x13, 0xd9, 0xfb, 0xae, 0xf2, 0xc3, 0x34, 0xe0, 0x87, 0xdf, 0x9b, 0x98, 0xe8, 0x4e,
-	0x09, 0xa1, 0x88, 0xd3, 0x9d, 0xf7, 0x37, 0xbe, 0x37, 0x31, 0x26, 0xff, 0xc7, 0x83, 0xd6, 0xd5,
-	0x49, 0x7a, 0x7a, 0xb5, 0xfd, 0xda, 0xbd, 0x35, 0xe1, 0x82, 0x57, 0xa7, 0xd7, 0x1a, 0x05, 0x62,
-	0x5e, 0x86, 0x5e, 0x84, 0x42, 0xcd, 0x21, 0x2d, 0xdf, 0x23, 0xb5, 0xd2, 0x48, 0xec, 0xe3, 0x98,
-	0x15, 0x30, 0xac, 0x4a, 0x51, 0x13, 0x06, 0x5c, 0x76, 0x0d, 0x2b, 0x9d, 0x63, 0xab, 0x27, 0x83,
-	0xbb, 0x1f, 0xbf, 0xd6, 0xf1, 0x67, 0x04, 0xfc, 0x37, 0x16, 0x3c, 0x74, 0xd9, 0x3d, 0x7a, 0x26,
-	0xb2, 0x9b, 0x8e, 0x44, 0xb5, 0xe1, 0x36, 0x6b, 0x01, 0xf1, 0x4a, 0x63, 0xcc, 0xb4, 0xc9, 0x46,
-	0x62, 0x46, 0xc0, 0xb0, 0x2a, 0x45, 0x7f, 0x11, 0x46, 0xfc, 0x4e, 0xc4, 0x36, 0x39, 0x9d, 0xff,
-	0xb0, 0x74, 0x9e, 0xa1, 0x33, 0xef, 0xe9, 0xaa, 0x5e, 0x80, 0x4d, 0x3c, 0x2a, 0x6c, 0x1b, 0x7e,
-	0x18, 0xd1, 0x3f, 0x4c, 0xd8, 0x5e, 0x36, 0x85, 0xed, 0x82, 0x56, 0x86, 0x0d, 0x4c, 0xf4, 0x0d,
-	0x0b, 0xce, 0xb7, 0x92, 0x17, 0x90, 0xd2, 0x15, 0x36, 0x32, 0x95, 0x2c, 0x14, 0xd5, 0x04, 0x69,
-	0x1e, 0xf4, 0xd5, 0x05, 0xc6, 0xdd, 0x8d, 0x60, 0x0f, 0xd8, 0xc2, 0x3d, 0xaf, 0xda, 0x08, 0x7c,
-	0xcf, 0x6c, 0xde, 0xb3, 0xac, 0x79, 0x6f, 0x65, 0xb4, 0xcb, 0xd2, 0x58, 0x94, 0x9f, 0x3d, 0xd8,
-	0x9f, 0xb8, 0x94, 0x5a, 0x84, 0xd3, 0x1b, 0x35, 0x3e, 0x0b, 0x97, 0xd3, 0x77, 0xea, 0xa3, 0x34,
-	0xe6, 0x3e, 0x5d, 0x63, 0x9e, 0x83, 0x67, 0x7b, 0x36, 0x8a, 0xca, 0x7c, 0xa9, 0x5e, 0x59, 0xa6,
-	0xcc, 0xef, 0x52, 0x87, 0xce, 0xc1, 0xb0, 0x9e, 0xc8, 0x83, 0xb9, 0xb2, 0xb5, 0xc7, 0x93, 0xf4,
-	0x92, 0xed, 0x57, 0x32, 0xf7, 0x09, 0xaf, 0x56, 0xba, 0x7c, 0xc2, 0x0a, 0x84, 0x63, 0x86, 0xc7,
-	0x71, 0x65, 0xa7, 0xbe, 0xf4, 0x7c, 0xca, 0xcd, 0x3e, 0xb1, 0x2b, 0xfb, 0xdf, 0xf5, 0x43, 0x4c,
-	0x09, 0xbd, 0x04, 0x05, 0xe2, 0xd5, 0xda, 0xbe, 0xeb, 0x45, 0x49, 0x33, 0xc8, 0x5d, 0x01, 0xc7,
-	0x0a, 0x43, 0x73, 0x7c, 0xe7, 0x8e, 0x74, 0x7c, 0xd7, 0x60, 0xd4, 0x61, 0xb1, 0xaf, 0xb1, 0xdb,
-	0xb2, 0xef, 0xc4, 0x7e, 0x86, 0x69, 0x93, 0x02, 0x4e, 0x92, 0xa4, 0x5c, 0xc2, 0xb8, 0x2a, 0xe3,
-	0xd2, 0x7f, 0x62, 0x2e, 0x15, 0x93, 0x02, 0x4e, 0x92, 0x44, 0x6f, 0x43, 0xa9, 0xca, 0x5e, 0x1b,
-	0xf0, 0x3e, 0xde, 0xdb, 0x5c, 0xf1, 0xa3, 0xb5, 0x80, 0x84, 0xc4, 0xe3, 0x6e, 0xe5, 0x42, 0xf9,
-	0x86, 0x18, 0x85, 0xd2, 0x4c, 0x0f, 0x3c, 0xdc, 0x93, 0x02, 0xd5, 0xea, 0x98, 0xd3, 0xd4, 0x8d,
-	0xf6, 0xd6, 0xfd, 0x2d, 0xe2, 0x09, 0x57, 0x82, 0xd2, 0xea, 0x2a, 0x7a, 0x21, 0x36, 0x71, 0xd1,
-	0xcf, 0x5b, 0x30, 0xd2, 0x94, 0x56, 0x2d, 0xdc, 0x69, 0xca, 0x54, 0x21, 0x38, 0x93, 0xe5, 0xb7,
-	0xa4, 0x53, 0xe6, 0x02, 0xdf, 0x00, 0x61, 0x93, 0xb7, 0xfd, 0x1d, 0x0b, 0xc6, 0x92, 0xd5, 0xd0,
-	0x16, 0x5c, 0x6b, 0x39, 0xc1, 0xd6, 0x3d, 0x6f, 0x33, 0x60, 0x71, 0x7f, 0x11, 0x9f, 0xd5, 0xe9,
-	0xcd, 0x88, 0x04, 0xb3, 0xce, 0x1e, 0x8f, 0xee, 0xc9, 0xab, 0xec, 0x46, 0xd7, 0x96, 0x8f, 0x42,
-	0xc6, 0x47, 0xd3, 0x42, 0x15, 0xb8, 0x44, 0x11, 0x66, 0x49, 0x93, 0x50, 0x09, 0x15, 0x33, 0xc9,
-	0x31, 0x26, 0xca, 0x7f, 0xbd, 0x9c, 0x86, 0x84, 0xd3, 0xeb, 0xda, 0xff, 0x36, 0x07, 0xf2, 0xfc,
-	0xfc, 0xd1, 0xb6, 0xc9, 0x22, 0x1b, 0x06, 0x02, 0x76, 0x93, 0x15, 0xd7, 0x33, 0xa6, 0xca, 0xf0,
-	0xbb, 0x2d, 0x16, 0x25, 0x54, 0xb1, 0x20, 0xbb, 0x6e, 0x34, 0xe3, 0xd7, 0xe4, 0xa5, 0x8c, 0x29,
-	0x16, 0x77, 0x05, 0x0c, 0xab, 0x52, 0xfb, 0xaf, 0x5b, 0x30, 0x42, 0x7b, 0xd9, 0x6c, 0x92, 0x66,
-	0x25, 0x22, 0xed, 0x10, 0x85, 0x90, 0x0f, 0xe9, 0x8f, 0xec, 0x4c, 0x04, 0x71, 0xc0, 0x39, 0x69,
-	0x6b, 0xc6, 0x50, 0xca, 0x04, 0x73, 0x5e, 0xf6, 0x7f, 0xcb, 0x41, 0x51, 0x0d, 0xf6, 0x31, 0x2c,
-	0xac, 0xb7, 0xe3, 0x27, 0xde, 0x5c, 0x06, 0x96, 0xb4, 0xe7, 0xdd, 0xf4, 0x26, 0x35, 0xed, 0xed,
-	0xf1, 0x17, 0xa3, 0xf1, 0x5b, 0xef, 0x97, 0x4c, 0x7f, 0xc3, 0x65, 0xdd, 0x88, 0xad, 0xe1, 0x0b,
-	0xc7, 0xc3, 0xae, 0xee, 0xee, 0xe9, 0xcf, 0xea, 0x3c, 0x51, 0x8e, 0x9d, 0xde, 0x7e, 0x9e, 0x44,
-	0x9e, 0xa2, 0xfc, 0xb1, 0xf2, 0x14, 0xdd, 0x82, 0x7e, 0xe2, 0x75, 0x5a, 0x2c, 0xda, 0xb9, 0xc8,
-	0x34, 0xa9, 0xfe, 0xbb, 0x5e, 0xa7, 0x65, 0xf6, 0x8c, 0xa1, 0xd8, 0xff, 0xdc, 0x02, 0xaa, 0x8f,
-	0xcf, 0xcf, 0xa0, 0xbf, 0x0c, 0x85, 0x50, 0x68, 0x01, 0x62, 0xa8, 0x7f, 0x4c, 0x05, 0xd4, 0x09,
-	0xf8, 0xe1, 0xfe, 0xc4, 0x08, 0x43, 0x96, 0x00, 0xac, 0xaa, 0xa0, 0x26, 0x8c, 0x30, 0x3b, 0xa2,
-	0x94, 0xe4, 0xc2, 0xf2, 0x7b, 0xe7, 0x98, 0x6f, 0x86, 0xf4, 0xaa, 0x42, 0xae, 0xe9, 0x20, 0x6c,
-	0x12, 0xb7, 0x7f, 0xb7, 0x1f, 0x34, 0x73, 0xdb, 0x31, 0x96, 0xc8, 0xbb, 0x09, 0xe3, 0xea, 0x72,
-	0x26, 0xc6, 0x55, 0x69, 0xb1, 0xe4, 0xdb, 0xce, 0xb4, 0xa7, 0xd2, 0x46, 0x35, 0x48, 0xb3, 0x2d,
-	0x16, 0x98, 0x6a, 0xd4, 0x02, 0x69, 0xb6, 0x31, 0x2b, 0x51, 0xd1, 0xd6, 0xfd, 0x3d, 0xa3, 0xad,
-	0x1b, 0x90, 0xaf, 0x3b, 0x9d, 0x3a, 0x11, 0x4e, 0xf4, 0x0c, 0xec, 0xe8, 0x2c, 0xfc, 0x8c, 0xdb,
-	0xd1, 0xd9, 0x4f, 0xcc, 0x19, 0xd0, 0x15, 0xde, 0x90, 0xce, 0x38, 0x61, 0x4a, 0xc9, 0x60, 0x85,
-	0x2b, 0xff, 0x1e, 0x5f, 0xe1, 0xea, 0x2f, 0x8e, 0x99, 0xd1, 0x9b, 0x56, 0x95, 0x3f, 0x35, 0x14,
-	0x47, 0xe5, 0xbd, 0x2c, 0xc2, 0xc9, 0x19, 0x41, 0x7e, 0xd3, 0x12, 0x7f, 0xb0, 0x64, 0x63, 0x4f,
-	0xc1, 0x90, 0x96, 0xb1, 0x87, 0x4e, 0x83, 0x7a, 0xe5, 0xa6, 0x4d, 0xc3, 0xac, 0x13, 0x39, 0x98,
-	0x95, 0xd8, 0x7f, 0xbf, 0x0f, 0xd4, 0x8d, 0x57, 0x0f, 0x7e, 0x76, 0xaa, 0xda, 0x53, 0x77, 0xe3,
-	0x15, 0x8c, 0xef, 0x61, 0x51, 0x4a, 0xd5, 0x89, 0x16, 0x09, 0xea, 0x4a, 0xc7, 0x16, 0x32, 0x4a,
-	0xa9, 0x13, 0xcb, 0x7a, 0x21, 0x36, 0x71, 0xa9, 0x2e, 0xd8, 0x72, 0x3c, 0x77, 0x93, 0x84, 0x51,
-	0x32, 0x86, 0x65, 0x59, 0xc0, 0xb1, 0xc2, 0x40, 0xf3, 0x70, 0x3e, 0x24, 0xd1, 0xea, 0x8e, 0x47,
-	0x02, 0xf5, 0x3a, 0x47, 0x3c, 0xd7, 0x52, 0x71, 0x5d, 0x95, 0x24, 0x02, 0xee, 0xae, 0x83, 0x66,
-	0x61, 0x4c, 0xbc, 0x94, 0x52, 0x0f, 0x5d, 0x84, 0xec, 0x51, 0x39, 0xd6, 0x2a, 0x89, 0x72, 0xdc,
-	0x55, 0x83, 0x52, 0xd9, 0x74, 0xdc, 0x66, 0x27, 0x20, 0x31, 0x95, 0x01, 0x93, 0xca, 0x5c, 0xa2,
-	0x1c, 0x77, 0xd5, 0x60, 0xa1, 0x85, 0x4d, 0xa7, 0x1e, 0x96, 0x06, 0xb5, 0xd0, 0x42, 0x0a, 0xc0,
-	0x1c, 0x6e, 0xff, 0x53, 0x0b, 0x46, 0x30, 0x89, 0x82, 0xbd, 0xe9, 0xcd, 0x4d, 0xd7, 0x73, 0xa3,
-	0x3d, 0xf4, 0x2b, 0x16, 0x8c, 0x79, 0x7e, 0x8d, 0x4c, 0x7b, 0x91, 0x2b, 0x81, 0xd9, 0xa5, 0x33,
-	0x61, 0xbc, 0x56, 0x12, 0xe4, 0xf9, 0xa3, 0xab, 0x24, 0x14, 0x77, 0x35, 0xc3, 0xbe, 0x02, 0x97,
-	0x52, 0x09, 0xd8, 0xdf, 0xe9, 0x13, 0xdd, 0x50, 0x93, 0xff, 0x3a, 0xe4, 0x9b, 0xec, 0x01, 0x9a,
-	0xf5, 0x98, 0xf9, 0x11, 0xd8, 0x58, 0xf1, 0x17, 0x6a, 0x9c, 0x12, 0x9a, 0x85, 0xa1, 0x80, 0xf2,
-	0x10, 0xcf, 0x03, 0xf9, 0x52, 0xb4, 0xe3, 0x7c, 0x6f, 0xaa, 0xe8, 0xd0, 0xfc, 0x8b, 0xf5, 0x6a,
-	0xe8, 0x3d, 0x18, 0xdc, 0xe0, 0x29, 0x1f, 0xb2, 0x33, 0x6c, 0x8b, 0x1c, 0x12, 0xec, 0x24, 0x96,
-	0x09, 0x25, 0x0e, 0xe3, 0x9f, 0x58, 0x72, 0x44, 0x7b, 0x50, 0x70, 0xe4, 0x9c, 0xf6, 0x67, 0x15,
-	0x8c, 0x66, 0xac, 0x1f, 0xae, 0x1f, 0xa9, 0x39, 0x54, 0xec, 0xe8, 0x61, 0x4c, 0xe2, 0x94, 0x77,
-	0x89, 0xc3, 0x58, 0x4b, 0x77, 0xa7, 0x61, 0xd9, 0xdf, 0xb2, 0x00, 0xe2, 0x64, 0x50, 0x68, 0x17,
-	0x0a, 0xe1, 0x1d, 0xe3, 0x62, 0x9a, 0xc5, 0xfb, 0x1e, 0x41, 0x51, 0x8b, 0x81, 0x17, 0x10, 0xac,
-	0xb8, 0x3d, 0xea, 0x32, 0xfd, 0xbf, 0x2c, 0xb8, 0x98, 0x96, 0xb4, 0xea, 0x29, 0xb6, 0xf8, 0xa4,
-	0xf7, 0x68, 0x51, 0x61, 0x2d, 0x20, 0x9b, 0xee, 0x6e, 0xd2, 0xa5, 0xbd, 0x28, 0x0b, 0x70, 0x8c,
-	0x63, 0x7f, 0x3d, 0x0f, 0x8a, 0xf1, 0x29, 0xdd, 0xbb, 0x6f, 0x52, 0x0d, 0xbd, 0x1e, 0xa7, 0x22,
-	0x51, 0x78, 0x98, 0x41, 0xb1, 0x28, 0xa5, 0x5a, 0xba, 0x0c, 0xd6, 0x15, 0x22, 0x9b, 0xad, 0x42,
-	0x19, 0xd7, 0x8b, 0x55, 0x69, 0xda, 0x4d, 0x3e, 0x7f, 0x26, 0x37, 0xf9, 0x81, 0xec, 0x6f, 0xf2,
-	0xb7, 0x60, 0x30, 0xf0, 0x9b, 0x64, 0x1a, 0xaf, 0x08, 0x37, 0x48, 0x9c, 0x42, 0x87, 0x83, 0xb1,
-	0x2c, 0x47, 0xaf, 0xc0, 0x50, 0x27, 0x24, 0x95, 0xd9, 0xc5, 0x99, 0x80, 0xd4, 0x42, 0x11, 0xff,
-	0xac, 0xdc, 0x51, 0xf7, 0xe3, 0x22, 0xac, 0xe3, 0xa1, 0xdf, 0xb6, 0x8e, 0x30, 0x16, 0x14, 0xb3,
-	0x3a, 0x13, 0x52, 0x93, 0x1f, 0x94, 0xaf, 0x3e, 0x9e, 0x05, 0xc2, 0xfe, 0xaa, 0x05, 0xe7, 0x2a,
-	0xd5, 0xc0, 0x6d, 0xc7, 0xc9, 0x2c, 0xb2, 0xce, 0xb5, 0x71, 0x53, 0xbd, 0x77, 0x4a, 0x2c, 0x5f,
-	0xf3, 0x85, 0x92, 0xfd, 0x0e, 0x8c, 0x55, 0x48, 0xcb, 0x69, 0x37, 0x58, 0xf8, 0x38, 0x77, 0xdf,
-	0x4e, 0x41, 0x31, 0x94, 0xb0, 0x64, 0xb2, 0x32, 0x85, 0x8c, 0x63, 0x1c, 0xf4, 0x02, 0x77, 0x35,
-	0xcb, 0xc0, 0xbf, 0x22, 0xd7, 0xcb, 0xb8, 0x7f, 0x3a, 0xc4, 0xb2, 0xcc, 0xde, 0x81, 0xe1, 0xb8,
-	0x3a, 0xd9, 0x44, 0x75, 0x18, 0xad, 0x6a, 0x11, 0xa2, 0x71, 0x48, 0xdb, 0xf1, 0x83, 0x49, 0xd9,
-	0x2a, 0x9c, 0x31, 0x89, 0xe0, 0x24, 0x55, 0xfb, 0x17, 0x73, 0x30, 0xaa, 0x38, 0x0b, 0x23, 0xea,
-	0x07, 0x49, 0xf7, 0x38, 0xce, 0xe2, 0x1d, 0xa6, 0x39, 0x92, 0x47, 0xb8, 0xc8, 0x3f, 0x48, 0xba,
-	0xc8, 0x4f, 0x95, 0x7d, 0x97, 0x5d, 0xf8, 0x5b, 0x39, 0x28, 0xa8, 0x57, 0xa1, 0xaf, 0x43, 0x9e,
-	0xa9, 0xce, 0x4f, 0xa6, 0x87, 0x30, 0x35, 0x1c, 0x73, 0x4a, 0x94, 0x24, 0xf3, 0x0d, 0x3e, 0x76,
-	0xea, 0xa7, 0x22, 0xb7, 0x1a, 0x38, 0x41, 0x84, 0x39, 0x25, 0xb4, 0x08, 0x7d, 0xc4, 0xab, 0x09,
-	0x85, 0xe4, 0xe4, 0x04, 0x59, 0xa2, 0xbe, 0xbb, 0x5e, 0x0d, 0x53, 0x2a, 0x2c, 0x4f, 0x0a, 0x3f,
-	0x77, 0xfa, 0xcd, 0xed, 0x21, 0x0e, 0x1d, 0x51, 0x6a, 0xff, 0x7c, 0x1f, 0x0c, 0x54, 0x3a, 0x1b,
-	0x54, 0xb5, 0xfa, 0xc7, 0x16, 0x5c, 0xd8, 0x49, 0xa4, 0x05, 0x8a, 0x97, 0xec, 0xfd, 0xec, 0x73,
-	0x2e, 0x61, 0xb2, 0x59, 0x7e, 0x4e, 0xb4, 0xeb, 0x42, 0x4a, 0x21, 0x4e, 0x6b, 0x8e, 0x91, 0x42,
-	0xa5, 0xef, 0x94, 0x92, 0x4d, 0x9d, 0x6e, 0x60, 0xde, 0x48, 0xcf, 0xa0, 0xbc, 0x3f, 0xeb, 0x07,
-	0xe0, 0xb3, 0xb1, 0xda, 0x8e, 0x8e, 0x63, 0x16, 0x78, 0x15, 0x86, 0x65, 0xb6, 0xf7, 0x95, 0x38,
-	0x18, 0x42, 0x39, 0xc4, 0xe6, 0xb5, 0x32, 0x6c, 0x60, 0x32, 0x55, 0xd0, 0x8b, 0x82, 0x3d, 0xae,
-	0x2e, 0xf4, 0x27, 0x54, 0x41, 0x55, 0x82, 0x35, 0x2c, 0x34, 0x69, 0x98, 0x2a, 0xf9, 0xf3, 0xf5,
-	0x73, 0x47, 0x58, 0x16, 0x3f, 0x0d, 0x23, 0xea, 0xdf, 0x9c, 0xdb, 0x24, 0x49, 0x43, 0xf4, 0x9a,
-	0x5e, 0x88, 0x4d, 0x5c, 0xf4, 0x19, 0x38, 0x67, 0xbe, 0x42, 0x13, 0x07, 0xac, 0x7a, 0x03, 0x6a,
-	0x3e, 0x5e, 0xc3, 0x09, 0x6c, 0xba, 0x03, 0x6a, 0xc1, 0x1e, 0xee, 0x78, 0xe2, 0xa4, 0x55, 0x3b,
-	0x60, 0x96, 0x41, 0xb1, 0x28, 0xa5, 0x43, 0x48, 0x6b, 0x92, 0x80, 0xc3, 0xc5, 0x33, 0x22, 0x35,
-	0x84, 0x15, 0xad, 0x0c, 0x1b, 0x98, 0x94, 0x83, 0xb0, 0xc9, 0x80, 0xb9, 0xc7, 0x12, 0x86, 0x94,
-	0x36, 0x9c, 0xf3, 0xcd, 0x2b, 0x2d, 0x0f, 0x1f, 0xf8, 0xe4, 0x31, 0xd7, 0xad, 0x51, 0x97, 0x87,
-	0xbd, 0x27, 0x6e, 0xc0, 0x09, 0xfa, 0x54, 0xd5, 0xd0, 0xc3, 0x03, 0x87, 0xcd, 0xc8, 0x97, 0x5e,
-	0x11, 0x7c, 0xf6, 0x05, 0x38, 0x5f, 0xe9, 0xb4, 0xdb, 0x4d, 0x97, 0xd4, 0x94, 0x2d, 0xcf, 0xfe,
-	0x29, 0x18, 0x15, 0x19, 0x52, 0xd4, 0x59, 0x7e, 0xa2, 0x34, 0x79, 0xf6, 0x9f, 0x5a, 0x30, 0x9a,
-	0xf0, 0xf3, 0xa1, 0xf7, 0x92, 0x27, 0x70, 0x26, 0xa6, 0x59, 0xfd, 0xf0, 0xe5, 0xbb, 0x2c, 0xf5,
-	0x34, 0x6f, 0xc8, 0xa8, 0xb4, 0xcc, 0x82, 0x3b, 0x59, 0xec, 0x16, 0x17, 0xe9, 0x7a, 0x68, 0x9b,
-	0xfd, 0x95, 0x1c, 0xa4, 0x3b, 0x57, 0xd1, 0x17, 0xbb, 0x07, 0xe0, 0xf5, 0x0c, 0x07, 0x40, 0x78,
-	0x77, 0x7b, 0x8f, 0x81, 0x67, 0x8e, 0xc1, 0x72, 0x46, 0x63, 0x20, 0xf8, 0x76, 0x8f, 0xc4, 0x9f,
-	0x58, 0x30, 0xb4, 0xbe, 0xbe, 0xa4, 0x4c, 0x03, 0x18, 0x2e, 0x87, 0xfc, 0x8d, 0x06, 0xf3, 0x8a,
-	0xcc, 0xf8, 0xad, 0x36, 0x77, 0x92, 0x08, 0xe7, 0x0d, 0x4b, 0x56, 0x53, 0x49, 0xc5, 0xc0, 0x3d,
-	0x6a, 0xa2, 0x7b, 0x70, 0x41, 0x2f, 0x11, 0x06, 0x1e, 0xe1, 0xa8, 0xe1, 0xaf, 0x16, 0xbb, 0x8b,
-	0x71, 0x5a, 0x9d, 0x24, 0x29, 0x61, 0xe5, 0x11, 0xdf, 0x11, 0xe8, 0x22, 0x25, 0x8a, 0x71, 0x5a,
-	0x1d, 0x7b, 0x15, 0x86, 0xb4, 0xaf, 0x5a, 0xa0, 0xcf, 0xc2, 0x58, 0xd5, 0x6f, 0xc9, 0xdb, 0xf5,
-	0x12, 0xd9, 0x26, 0x4d, 0xd1, 0x65, 0x66, 0x80, 0x99, 0x49, 0x94, 0xe1, 0x2e, 0x6c, 0xfb, 0x37,
-	0x2c, 0xe8, 0x67, 0x09, 0x5a, 0x6e, 0xc2, 0x80, 0xe7, 0xd7, 0xc8, 0xbd, 0xae, 0x87, 0x3d, 0x2b,
-	0x14, 0x3a, 0x8b, 0x45, 0x29, 0xbd, 0x00, 0x1b, 0x69, 0x5a, 0x32, 0xb9, 0x00, 0xab, 0xc4, 0x81,
-	0x47, 0x84, 0xb8, 0xdb, 0x1f, 0x5e, 0x07, 0x05, 0x3e, 0xc6, 0x69, 0xd6, 0x56, 0x11, 0x32, 0xf9,
-	0x8c, 0x23, 0x64, 0xd4, 0xd0, 0x24, 0xa2, 0x64, 0xa2, 0x38, 0x4a, 0x66, 0x20, 0xeb, 0x28, 0x19,
-	0xa5, 0x9c, 0x76, 0x45, 0xca, 0xfc, 0x92, 0x05, 0xc3, 0x74, 0x6e, 0x94, 0xaf, 0x61, 0x90, 0x69,
-	0xc8, 0x6f, 0x67, 0x37, 0x2b, 0x3c, 0xe2, 0x43, 0x90, 0xe7, 0x71, 0x54, 0xea, 0x44, 0xd3, 0x8b,
-	0xb0, 0xd1, 0x0e, 0x34, 0xa7, 0x99, 0xa6, 0x78, 0xf2, 0x96, 0xab, 0x69, 0x37, 0x95, 0x47, 0xda,
-	0x99, 0x76, 0x35, 0x1d, 0xad, 0x98, 0xd5, 0x8a, 0x93, 0xc1, 0xe0, 0x9a, 0x05, 0x59, 0xa6, 0x86,
-	0x8a, 0x75, 0x37, 0x1b, 0x06, 0x78, 0xc0, 0x95, 0xf8, 0x14, 0x04, 0x73, 0x6c, 0xf0, 0x60, 0x2c,
-	0x2c, 0x4a, 0x50, 0x24, 0x7d, 0x82, 0x43, 0x59, 0x65, 0x54, 0x34, 0x7c, 0x8e, 0xe9, 0x4e, 0x41,
-	0xf4, 0x9a, 0x7e, 0x01, 0x1e, 0x3e, 0xce, 0x05, 0x78, 0xa4, 0xe7, 0xe5, 0xf7, 0x6b, 0x16, 0x0c,
-	0x57, 0xb5, 0x94, 0x91, 0xa5, 0x17, 0xb3, 0xca, 0x8b, 0x9a, 0x96, 0x88, 0x92, 0x3f, 0xad, 0xd2,
-	0x4b, 0xb0, 0xc1, 0x9d, 0xe5, 0x1e, 0x61, 0xb7, 0x7d, 0x16, 0x01, 0x37, 0x74, 0x7b, 0x2d, 0x83,
-	0x93, 0xcc, 0xb0, 0x1e, 0xf0, 0x69, 0xe4, 0x30, 0x2c, 0x78, 0xa1, 0xf7, 0xa1, 0x20, 0x63, 0xf6,
-	0x44, 0x44, 0x1d, 0xce, 0xc2, 0x8e, 0x6a, 0x7a, 0x49, 0x64, 0xc6, 0x02, 0x0e, 0xc5, 0x8a, 0x23,
-	0x6a, 0x40, 0x5f, 0xcd, 0xa9, 0x8b, 0xd8, 0xba, 0xe5, 0x6c, 0x12, 0xc2, 0x48, 0x9e, 0xec, 0x2a,
-	0x37, 0x3b, 0x3d, 0x8f, 0x29, 0x0b, 0xb4, 0x1b, 0x67, 0xae, 0x1b, 0xcb, 0x4c, 0x51, 0x30, 0x35,
-	0x3a, 0x6e, 0xcf, 0xe8, 0x4a, 0x84, 0x57, 0x13, 0x8e, 0xa5, 0x3f, 0xc7, 0xd8, 0xce, 0x65, 0x93,
-	0x51, 0x86, 0xbf, 0xc2, 0x8c, 0x9d, 0x53, 0x94, 0x0b, 0xfb, 0x66, 0xc8, 0x4f, 0x64, 0xc5, 0x65,
-	0x61, 0x7d, 0x7d, 0xad, 0xeb, 0x5b, 0x21, 0x77, 0x61, 0x90, 0xe7, 0x1e, 0xe5, 0xd1, 0x86, 0x43,
-	0xb7, 0xc7, 0x7b, 0x67, 0x30, 0x8d, 0x45, 0x37, 0xff, 0x1f, 0x62, 0x59, 0x17, 0xfd, 0xa2, 0x05,
-	0xe7, 0xa8, 0x8c, 0x8b, 0x93, 0xa5, 0x96, 0x50, 0x56, 0x52, 0xe4, 0x7e, 0x48, 0xd5, 0x19, 0xb9,
-	0xfb, 0xd5, 0x3d, 0xe7, 0x9e, 0xc1, 0x0e, 0x27, 0xd8, 0xa3, 0x0f, 0xa0, 0x10, 0xba, 0x35, 0x52,
-	0x75, 0x82, 0xb0, 0x74, 0xe1, 0x74, 0x9a, 0x12, 0xdb, 0xb8, 0x05, 0x23, 0xac, 0x58, 0xa2, 0xbf,
-	0xcd, 0x52, 0x9b, 0x8b, 0xcf, 0x50, 0x88, 0x2f, 0x24, 0x5d, 0x3c, 0xb5, 0x2f, 0x24, 0x71, 0xd3,
-	0xaf, 0xc9, 0x0e, 0x27, 0xf9, 0xa3, 0xbf, 0x66, 0xc1, 0x25, 0x9e, 0xc2, 0x2f, 0x99, 0xbf, 0xf1,
-	0xd2, 0x63, 0x1a, 0x57, 0x58, 0x98, 0xe4, 0x74, 0x1a, 0x49, 0x9c, 0xce, 0x89, 0xe5, 0x1c, 0x0a,
-	0x74, 0x6f, 0x18, 0x0b, 0x56, 0xcd, 0xce, 0xd7, 0xa3, 0x3e, 0xb8, 0xc4, 0x82, 0x0d, 0x0c, 0x10,
-	0x36, 0x19, 0xa3, 0x97, 0x61, 0xa8, 0x2d, 0x0e, 0x28, 0x37, 0x6c, 0xb1, 0xa0, 0xd7, 0x3e, 0xfe,
-	0x30, 0x60, 0x2d, 0x06, 0x63, 0x1d, 0xc7, 0x48, 0x40, 0x75, 0xeb, 0xa8, 0x04, 0x54, 0xe8, 0x3e,
-	0x0c, 0x45, 0x7e, 0x93, 0x04, 0xe2, 0xaa, 0x59, 0x62, 0x2b, 0xf0, 0x7a, 0xda, 0xde, 0x5a, 0x57,
-	0x68, 0xf1, 0x55, 0x34, 0x86, 0x85, 0x58, 0xa7, 0xc3, 0x62, 0xd8, 0x44, 0x6a, 0xc4, 0x80, 0x59,
-	0x36, 0x9e, 0x4d, 0xc4, 0xb0, 0xe9, 0x85, 0xd8, 0xc4, 0x45, 0xf3, 0x70, 0xbe, 0x1d, 0xb8, 0x7e,
-	0xe0, 0x46, 0x7b, 0x33, 0x4d, 0x27, 0x0c, 0x19, 0x01, 0x1e, 0xf6, 0xae, 0xdc, 0xc8, 0x6b, 0x49,
-	0x04, 0xdc, 0x5d, 0x87, 0x0e, 0x83, 0x04, 0x96, 0x9e, 0x63, 0x4a, 0xfa, 0x30, 0x0f, 0x99, 0xe7,
-	0x30, 0xac, 0x4a, 0x7b, 0xa4, 0x63, 0xba, 0xfa, 0x38, 0xe9, 0x98, 0x50, 0x0d, 0xae, 0x3a, 0x9d,
-	0xc8, 0x67, 0xef, 0x7a, 0xcd, 0x2a, 0x3c, 0x9c, 0xef, 0x06, 0x8f, 0x10, 0x3c, 0xd8, 0x9f, 0xb8,
-	0x3a, 0x7d, 0x04, 0x1e, 0x3e, 0x92, 0x0a, 0xfa, 0x02, 0x14, 0x88, 0x48, 0x29, 0x55, 0xfa, 0xb1,
-	0xac, 0x8e, 0x6d, 0x33, 0x49, 0x95, 0x8c, 0xd3, 0xe2, 0x30, 0xac, 0xf8, 0xa1, 0x75, 0x18, 0x6a,
-	0xf8, 0x61, 0x34, 0xdd, 0x74, 0x9d, 0x90, 0x84, 0xa5, 0x6b, 0x6c, 0xd1, 0xa4, 0x6a, 0x43, 0x0b,
-	0x12, 0x2d, 0x5e, 0x33, 0x0b, 0x71, 0x4d, 0xac, 0x93, 0x41, 0x84, 0x79, 0x7c, 0x58, 0x2c, 0x23,
-	0x95, 0x5d, 0x64, 0x37, 0x2a, 0x5d, 0x67, 0x1d, 0xbb, 0x99, 0x46, 0x79, 0xcd, 0xaf, 0x55, 0x4c,
-	0x6c, 0xe5, 0xf2, 0xd1, 0x81, 0x38, 0x49, 0x13, 0xbd, 0x0a, 0xc3, 0x6d, 0xbf, 0x56, 0x69, 0x93,
-	0xea, 0x9a, 0x13, 0x55, 0x1b, 0xa5, 0x09, 0xd3, 0xe6, 0xb6, 0xa6, 0x95, 0x61, 0x03, 0x13, 0xb5,
-	0x61, 0xb0, 0xc5, 0x9f, 0xf4, 0x95, 0x9e, 0xcf, 0xea, 0xb6, 0x21, 0xde, 0x08, 0xf2, 0x13, 0x5c,
-	0xfc, 0xc1, 0x92, 0x0d, 0xfa, 0x47, 0x16, 0x8c, 0x26, 0xc2, 0xb8, 0x4b, 0x3f, 0x9e, 0x99, 0x12,
-	0x61, 0x12, 0x2e, 0xdf, 0x64, 0xc3, 0x67, 0x02, 0x0f, 0xbb, 0x41, 0x38, 0xd9, 0x22, 0x3e, 0x2e,
-	0xec, 0x5d, 0x6e, 0xe9, 0x85, 0xec, 0xc6, 0x85, 0x11, 0x94, 0xe3, 0xc2, 0xfe, 0x60, 0xc9, 0x06,
-	0xdd, 0x82, 0x41, 0x91, 0x29, 0xa2, 0x74, 0xd3, 0x74, 0xdb, 0x89, 0x84, 0x12, 0x58, 0x96, 0x8f,
-	0xff, 0x14, 0x9c, 0xef, 0xba, 0x4c, 0x9d, 0xe8, 0x71, 0xe8, 0x2f, 0x5b, 0xa0, 0xbf, 0xc0, 0xca,
-	0x3c, 0xaf, 0xea, 0xab, 0x30, 0x5c, 0xe5, 0x49, 0xfd, 0xf9, 0x1b, 0xae, 0x7e, 0xd3, 0x80, 0x39,
-	0xa3, 0x95, 0x61, 0x03, 0xd3, 0x5e, 0x00, 0xd4, 0x9d, 0x64, 0x2f, 0x11, 0x24, 0x60, 0x1d, 0x2b,
-	0x48, 0xe0, 0xd7, 0x2d, 0x18, 0x31, 0x74, 0x86, 0xcc, 0xfd, 0x7d, 0x73, 0x80, 0x5a, 0x6e, 0x10,
-	0xf8, 0x81, 0x9e, 0x4f, 0x5e, 0x64, 0x15, 0x63, 0x19, 0x57, 0x96, 0xbb, 0x4a, 0x71, 0x4a, 0x0d,
-	0xfb, 0xf7, 0xfa, 0x20, 0x0e, 0x54, 0x54, 0xb9, 0x96, 0xac, 0x9e, 0xb9, 0x96, 0x5e, 0x82, 0xc2,
-	0x3b, 0xa1, 0xef, 0xad, 0xc5, 0x19, 0x99, 0xd4, 0x5c, 0xbc, 0x56, 0x59, 0x5d, 0x61, 0x98, 0x0a,
-	0x83, 0x61, 0xbf, 0x3b, 0xe7, 0x36, 0xa3, 0xee, 0x94, 0x3d, 0xaf, 0xbd, 0xce, 0xe1, 0x58, 0x61,
-	0xb0, 0x8c, 0xf7, 0xdb, 0x44, 0x59, 0xb6, 0xe3, 0x8c, 0xf7, 0x3c, 0x7f, 0x26, 0x2b, 0x43, 0x53,
-	0x50, 0x54, 0x86, 0x71, 0x61, 0xa7, 0x57, 0x23, 0xa5, 0x0c, 0xe8, 0x38, 0xc6, 0x61, 0x0a, 0xa1,
-	0xb0, 0xe2, 0x0a, 0xa3, 0x46, 0x25, 0x8b, 0x0b, 0x43, 0xc2, 0x2e, 0xcc, 0x65, 0xbb, 0x04, 0x63,
-	0xc5, 0x52, 0x0f, 0x66, 0xcd, 0x1f, 0x37, 0x98, 0xd5, 0x5c, 0x72, 0x85, 0x63, 0x2d, 0xb9, 0x9f,
-	0xed, 0x83, 0xc1, 0x07, 0x24, 0x60, 0xe9, 0xdd, 0x6e, 0xc1, 0xe0, 0x36, 0xff, 0x99, 0x7c, 0x30,
-	0x22, 0x30, 0xb0, 0x2c, 0xa7, 0xc3, 0xb9, 0xd1, 0x71, 0x9b, 0xb5, 0xd9, 0x78, 0x73, 0xa9, 0xe1,
-	0x2c, 0xcb, 0x02, 0x1c, 0xe3, 0xd0, 0x0a, 0x75, 0xaa, 0x70, 0xb7, 0x5a, 0x6e, 0x94, 0x8c, 0xc9,
-	0x98, 0x97, 0x05, 0x38, 0xc6, 0x41, 0x37, 0x61, 0xa0, 0xee, 0x46, 0xeb, 0x4e, 0x3d, 0xe9, 0x7a,
-	0x9b, 0x67, 0x50, 0x2c, 0x4a, 0x99, 0xef, 0xc6, 0x8d, 0xd6, 0x03, 0xc2, 0xac, 0xb5, 0x5d, 0x2f,
-	0x47, 0xe7, 0xb5, 0x32, 0x6c, 0x60, 0xb2, 0x26, 0xf9, 0xa2, 0x67, 0xc2, 0xa7, 0x12, 0x37, 0x49,
-	0x16, 0xe0, 0x18, 0x87, 0x2e, 0xcb, 0xaa, 0xdf, 0x6a, 0xbb, 0x4d, 0x11, 0xa3, 0xa8, 0x2d, 0xcb,
-	0x19, 0x01, 0xc7, 0x0a, 0x83, 0x62, 0x53, 0xc9, 0x42, 0xa5, 0x42, 0x32, 0xe9, 0xf7, 0x9a, 0x80,
-	0x63, 0x85, 0x61, 0x3f, 0x80, 0x11, 0xbe, 0xc1, 0x66, 0x9a, 0x8e, 0xdb, 0x9a, 0x9f, 0x41, 0x77,
-	0xbb, 0x02, 0x71, 0x6f, 0xa5, 0x04, 0xe2, 0x5e, 0x32, 0x2a, 0x75, 0x07, 0xe4, 0xda, 0xdf, 0xcd,
-	0x41, 0xe1, 0x0c, 0xbf, 0x9b, 0xd0, 0x36, 0xbe, 0x9b, 0x90, 0x75, 0xf6, 0xfc, 0xb4, 0x6f, 0x26,
-	0xec, 0x26, 0xbe, 0x99, 0xb0, 0x96, 0x65, 0x6c, 0xfa, 0x91, 0xdf, 0x4b, 0xf8, 0xa1, 0x05, 0x17,
-	0x25, 0x2a, 0x93, 0x35, 0x65, 0xd7, 0x63, 0x4e, 0xfb, 0xd3, 0x1f, 0xe6, 0xf7, 0x8d, 0x61, 0x7e,
-	0x33, 0xbb, 0x2e, 0xeb, 0xfd, 0xe8, 0xf9, 0x31, 0x9f, 0x1f, 0x58, 0x50, 0x4a, 0xab, 0x70, 0x06,
-	0x1f, 0x8c, 0x78, 0xcf, 0xfc, 0x60, 0xc4, 0x83, 0xd3, 0xe9, 0x79, 0x8f, 0x0f, 0x47, 0xfc, 0xb0,
-	0x47, 0xbf, 0xd9, 0x57, 0x1a, 0x9a, 0xf2, 0x14, 0xb2, 0xb2, 0x72, 0x87, 0x71, 0x16, 0xe9, 0xc7,
-	0x59, 0x13, 0x06, 0x42, 0xe6, 0xe1, 0x16, 0x4b, 0x60, 0x21, 0x8b, 0xb3, 0x89, 0xd2, 0x13, 0x36,
-	0x42, 0xf6, 0x1b, 0x0b, 0x1e, 0xf6, 0x7f, 0xb0, 0x60, 0xf8, 0x0c, 0xbf, 0x0a, 0xe2, 0x9b, 0x93,
-	0xfc, 0x5a, 0x76, 0x93, 0xdc, 0x63, 0x62, 0xff, 0xfb, 0x35, 0x30, 0x3e, 0xc0, 0x81, 0xde, 0x83,
-	0xa2, 0x54, 0x0c, 0xe5, 0x9b, 0x97, 0x2c, 0x9d, 0x3d, 0xea, 0x98, 0x91, 0x90, 0x10, 0xc7, 0xfc,
-	0x12, 0x31, 0x05, 0xb9, 0x63, 0xc5, 0x14, 0x3c, 0xdd, 0xaf, 0x02, 0xa4, 0x5f, 0xdb, 0xfb, 0x4f,
-	0xe5, 0xda, 0x7e, 0x35, 0xf3, 0x6b, 0xfb, 0xb5, 0x33, 0xbe, 0xb6, 0x6b, 0x36, 0xd4, 0xfc, 0x13,
-	0xd8, 0x50, 0xdf, 0x83, 0x8b, 0xdb, 0xf1, 0xe1, 0xaf, 0x56, 0x92, 0xf8, 0xb8, 0xc1, 0xad, 0xd4,
-	0xcb, 0x3a, 0x55, 0x64, 0xc2, 0x88, 0x78, 0x91, 0xa6, 0x36, 0xa8, 0x1c, 0x06, 0x17, 0x1f, 0xa4,
-	0x90, 0xc3, 0xa9, 0x4c, 0x92, 0xc6, 0xb0, 0xc1, 0x63, 0x18, 0xc3, 0x7e, 0xa3, 0xe7, 0x17, 0x46,
-	0x0b, 0xa7, 0xfb, 0x85, 0xd1, 0x67, 0x4f, 0xfc, 0x75, 0xd1, 0x17, 0x62, 0x5f, 0x01, 0x8f, 0x63,
-	0x49, 0x37, 0xec, 0xff, 0x6a, 0xd2, 0x01, 0x09, 0x6c, 0xe8, 0x3f, 0x9f, 0xad, 0xd6, 0x93, 0x81,
-	0x13, 0x72, 0xe8, 0x09, 0x9c, 0x90, 0x09, 0xcb, 0xe4, 0x70, 0x46, 0x96, 0x49, 0x0f, 0xc6, 0xdc,
-	0x96, 0x53, 0x27, 0x6b, 0x9d, 0x66, 0x93, 0x07, 0x01, 0xcb, 0x2f, 0x2f, 0xa4, 0x46, 0x75, 0x2e,
-	0xf9, 0x55, 0xa7, 0x99, 0xfc, 0xe0, 0x8c, 0x7a, 0x3e, 0x72, 0x2f, 0x41, 0x09, 0x77, 0xd1, 0xa6,
-	0x0b, 0x96, 0x65, 0x32, 0x20, 0x11, 0x1d, 0x6d, 0xe6, 0xe9, 0x12, 0x9f, 0x82, 0x5e, 0x88, 0xc1,
-	0x58, 0xc7, 0x41, 0x8b, 0x50, 0xac, 0x79, 0xa1, 0x78, 0x22, 0x31, 0xca, 0x84, 0xd9, 0x27, 0xa8,
-	0x08, 0x9c, 0x5d, 0xa9, 0xa8, 0xc7, 0x11, 0x57, 0x53, 0x92, 0x64, 0xa8, 0x72, 0x1c, 0xd7, 0x47,
-	0xcb, 0x8c, 0x98, 0x48, 0x9e, 0xcb, 0x1d, 0x50, 0x37, 0x7a, 0xd8, 0xd3, 0x66, 0x57, 0x64, 0xfa,
-	0xdf, 0x11, 0xc1, 0x4e, 0x64, 0xc1, 0x8d, 0x29, 0x68, 0x5f, 0xc0, 0x38, 0x7f, 0xe4, 0x17, 0x30,
-	0x58, 0x76, 0x9c, 0xa8, 0xa9, 0xac, 0xe7, 0xd7, 0x33, 0xcb, 0x8e, 0x13, 0x47, 0xa1, 0x88, 0xec,
-	0x38, 0x31, 0x00, 0xeb, 0x2c, 0xd1, 0x6a, 0x2f, 0x2f, 0xc2, 0x05, 0x26, 0x34, 0x4e, 0xee, 0x13,
-	0xd0, 0xcd, 0xc9, 0x17, 0x8f, 0x34, 0x27, 0x77, 0x99, 0xbf, 0x2f, 0x9d, 0xc0, 0xfc, 0xdd, 0x60,
-	0x79, 0x4b, 0xe6, 0x67, 0x84, 0xc7, 0x21, 0x03, 0x85, 0x8e, 0x3d, 0x9a, 0xe4, 0x51, 0x3d, 0xec,
-	0x27, 0xe6, 0x0c, 0xd0, 0x1a, 0x5c, 0x6c, 0xfb, 0xb5, 0x2e, 0x53, 0x3a, 0x73, 0x31, 0xc4, 0x29,
-	0x66, 0x2e, 0xae, 0xa5, 0xe0, 0xe0, 0xd4, 0x9a, 0x4c, 0x3c, 0xc7, 0x70, 0x96, 0x00, 0x27, 0x2f,
-	0xc4, 0x73, 0x0c, 0xc6, 0x3a, 0x4e, 0xd2, 0x98, 0xfc, 0xec, 0xa9, 0x19, 0x93, 0xc7, 0xcf, 0xc0,
-	0x98, 0xfc, 0xdc, 0xb1, 0x8d, 0xc9, 0x1f, 0xc0, 0x85, 0xb6, 0x5f, 0x9b, 0x75, 0xc3, 0xa0, 0xc3,
-	0xa2, 0xf5, 0xcb, 0x9d, 0x5a, 0x9d, 0x44, 0xcc, 0x1a, 0x3d, 0x74, 0xfb, 0xb6, 0xde, 0xc8, 0x36,
-	0xdb, 0xc8, 0x93, 0xdb, 0x2f, 0x6f, 0x90, 0x88, 0x4f, 0x66, 0xb2, 0x16, 0xbb, 0x30, 0xb1, 0xb0,
-	0xa6, 0x94, 0x42, 0x9c, 0xc6, 0x47, 0xb7, 0x65, 0xdf, 0x38, 0x1b, 0x5b, 0xf6, 0x67, 0xa1, 0x10,
-	0x36, 0x3a, 0x51, 0xcd, 0xdf, 0xf1, 0x98, 0xc3, 0xa2, 0xa8, 0xbe, 0x49, 0x57, 0xa8, 0x08, 0xf8,
-	0xe1, 0xfe, 0xc4, 0x98, 0xfc, 0xad, 0x99, 0x14, 0x04, 0x04, 0x7d, 0xb3, 0x47, 0x84, 0xb3, 0x7d,
-	0x9a, 0x11, 0xce, 0x57, 0x4e, 0x14, 0xdd, 0x9c, 0x66, 0xb0, 0x7f, 0xfe, 0x63, 0x67, 0xb0, 0xff,
-	0x15, 0x0b, 0x46, 0xb6, 0x75, 0xfb, 0x8d, 0x70, 0x2a, 0x64, 0xe0, 0xdc, 0x34, 0xcc, 0x42, 0x65,
-	0x9b, 0x0a, 0x3b, 0x03, 0x74, 0x98, 0x04, 0x60, 0xb3, 0x25, 0x29, 0x8e, 0xd7, 0x17, 0x9e, 0x96,
-	0xe3, 0xf5, 0x03, 0x26, 0xcc, 0x64, 0x94, 0x12, 0xf3, 0x34, 0x64, 0x1b, 0x09, 0x25, 0x05, 0xa3,
-	0x0a, 0x84, 0xd2, 0xf9, 0xa1, 0xaf, 0x59, 0x30, 0x26, 0x2f, 0x67, 0xc2, 0xfe, 0x1a, 0x8a, 0x58,
-	0x8e, 0x2c, 0xef, 0x84, 0x2c, 0x6e, 0x71, 0x3d, 0xc1, 0x07, 0x77, 0x71, 0x7e, 0x72, 0x47, 0xca,
-	0x1f, 0x22, 0x38, 0x97, 0xf8, 0xdc, 0xdf, 0x27, 0xcd, 0xf4, 0x87, 0xd7, 0x93, 0x39, 0xe8, 0x46,
-	0x24, 0xbe, 0x91, 0x87, 0xce, 0x48, 0x14, 0x97, 0x3b, 0xd5, 0x44, 0x71, 0x7d, 0x67, 0x93, 0x28,
-	0x6e, 0xec, 0x34, 0x12, 0xc5, 0x9d, 0x3f, 0x51, 0xa2, 0x38, 0x2d, 0x51, 0x5f, 0xff, 0x23, 0x12,
-	0xf5, 0x4d, 0xc3, 0xa8, 0x8c, 0x6a, 0x25, 0x22, 0x03, 0x18, 0x37, 0x7e, 0xab, 0x4f, 0xd7, 0xcf,
-	0x98, 0xc5, 0x38, 0x89, 0x8f, 0x3e, 0xb2, 0x20, 0xef, 0xb1, 0x9a, 0x03, 0x59, 0xe5, 0xbe, 0x35,
-	0x97, 0x16, 0xbb, 0xbc, 0x88, 0x8c, 0xb3, 0x32, 0x14, 0x27, 0xcf, 0x60, 0x87, 0xf2, 0x07, 0xe6,
-	0x2d, 0x40, 0x6f, 0x43, 0xc9, 0xdf, 0xdc, 0x6c, 0xfa, 0x4e, 0x2d, 0xce, 0x66, 0x27, 0xad, 0xf3,
-	0xfc, 0x65, 0x80, 0xca, 0xe6, 0xb3, 0xda, 0x03, 0x0f, 0xf7, 0xa4, 0x40, 0x6f, 0x9f, 0xa3, 0x61,
-	0xe4, 0x07, 0xa4, 0x16, 0xdf, 0x94, 0x8b, 0xac, 0xcf, 0x24, 0xf3, 0x3e, 0x57, 0x4c, 0x3e, 0xbc,
-	0xf7, 0x6a, 0x52, 0x12, 0xa5, 0x38, 0xd9, 0x2c, 0x14, 0xc0, 0xe5, 0x76, 0xda, 0x45, 0x3d, 0x14,
-	0x01, 0xae, 0x47, 0x99, 0x0b, 0xe4, 0xd6, 0xbd, 0x9c, 0x7a, 0xd5, 0x0f, 0x71, 0x0f, 0xca, 0x7a,
-	0x9e, 0xbb, 0xc2, 0xd9, 0xe4, 0xb9, 0x33, 0x3f, 0xd2, 0x39, 0x72, 0xe6, 0x1f, 0xe9, 0x44, 0x7f,
-	0x96, 0x9a, 0x92, 0x91, 0xdf, 0x6f, 0xeb, 0x99, 0xaf, 0x89, 0x8f, 0x5d, 0x5a, 0xc6, 0x7f, 0x62,
-	0xc1, 0x38, 0x5f, 0x79, 0x69, 0x1f, 0xf1, 0x17, 0xa1, 0xa0, 0x59, 0x3b, 0x70, 0x98, 0x8b, 0xb9,
-	0x62, 0x70, 0x65, 0x7e, 0x85, 0x23, 0x5a, 0x82, 0x7e, 0x29, 0x45, 0x97, 0x1b, 0xcd, 0xca, 0x62,
-	0x94, 0x9e, 0xce, 0xef, 0xc2, 0xc1, 0x71, 0xd4, 0xb7, 0x7f, 0xd6, 0xd3, 0xa0, 0x85, 0x58, 0xf3,
-	0xfe, 0xea, 0x29, 0x19, 0xb4, 0xf4, 0x9c, 0x83, 0x27, 0x31, 0x6b, 0x8d, 0xff, 0x9c, 0x48, 0x7a,
-	0xdc, 0x33, 0x35, 0xf7, 0x86, 0xf9, 0x0d, 0xc9, 0xa5, 0x2c, 0x13, 0x93, 0xea, 0x39, 0xc2, 0xff,
-	0xa6, 0x05, 0x17, 0xd3, 0x84, 0x64, 0x4a, 0x93, 0x3e, 0x6f, 0x36, 0x29, 0x43, 0x8d, 0x4b, 0x6f,
-	0x50, 0x36, 0xd9, 0x18, 0x7f, 0xb6, 0xa8, 0xb9, 0x11, 0x22, 0xd2, 0xfe, 0xff, 0xdf, 0xfe, 0xcd,
-	0x3a, 0xd3, 0xb2, 0xf1, 0x15, 0xdf, 0xfc, 0xd3, 0xfa, 0x8a, 0xef, 0xc0, 0xe3, 0x7c, 0xc5, 0x77,
-	0xf0, 0xa9, 0x7d, 0xc5, 0xb7, 0x70, 0xcc, 0xaf, 0xf8, 0x16, 0x3f, 0xa6, 0x5f, 0xf1, 0xfd, 0x35,
-	0xf5, 0x69, 0x5e, 0x7e, 0x38, 0xbf, 0x91, 0x6d, 0xf6, 0xb9, 0xff, 0xf7, 0xbe, 0xcf, 0xfb, 0xc7,
-	0x39, 0x18, 0x55, 0x47, 0xa9, 0x13, 0x6e, 0x55, 0x48, 0x74, 0x06, 0x31, 0x09, 0x3b, 0x46, 0x4c,
-	0x42, 0x96, 0x66, 0x20, 0xde, 0x85, 0x9e, 0x11, 0x20, 0x5f, 0x4a, 0x44, 0x80, 0x3c, 0xcc, 0x9e,
-	0xf5, 0xd1, 0x81, 0x20, 0xff, 0xc3, 0x82, 0x0b, 0x89, 0x1a, 0x67, 0xe0, 0x25, 0xdf, 0x36, 0xbd,
-	0xe4, 0xaf, 0x67, 0xde, 0xeb, 0x1e, 0xce, 0xf2, 0x0f, 0xbb, 0x7b, 0xcb, 0xf4, 0xb4, 0x2d, 0xf9,
-	0x75, 0x67, 0x2b, 0x2b, 0xb9, 0xdc, 0xfb, 0xd3, 0xce, 0xf6, 0x6f, 0xe6, 0xe0, 0x52, 0xea, 0x24,
-	0xa1, 0xaf, 0xa8, 0x2b, 0x2d, 0x6f, 0xc7, 0xc6, 0x29, 0xad, 0x06, 0xfd, 0x66, 0x3b, 0x62, 0xdc,
-	0x6c, 0xc5, 0x85, 0xf6, 0x69, 0xa9, 0x5b, 0x22, 0xed, 0xa7, 0x26, 0x0f, 0xfe, 0xa7, 0x05, 0x63,
-	0x49, 0xd5, 0xfa, 0x0c, 0x04, 0xc2, 0xae, 0x21, 0x10, 0x1e, 0x64, 0x6f, 0x17, 0xee, 0x19, 0xa0,
-	0xf4, 0xc7, 0x5a, 0x64, 0x96, 0x44, 0x3e, 0x83, 0x1d, 0xb9, 0x63, 0xee, 0x48, 0x9c, 0x7d, 0x8f,
-	0x7b, 0x6c, 0xc9, 0x77, 0x21, 0xcd, 0x34, 0x7e, 0xbc, 0xc4, 0x17, 0x46, 0xd0, 0x73, 0xee, 0xd8,
-	0x41, 0xcf, 0xbf, 0x90, 0xeb, 0x1e, 0x62, 0x26, 0x06, 0xbe, 0x4a, 0x15, 0x1f, 0xed, 0x6e, 0x97,
-	0x5d, 0x5e, 0x02, 0xe3, 0x26, 0xa9, 0xda, 0x68, 0xdc, 0x23, 0x0d, 0xce, 0xe8, 0x9d, 0xb8, 0x25,
-	0x74, 0xa6, 0x1e, 0x99, 0x64, 0xa6, 0xd7, 0x32, 0x67, 0xa6, 0xd9, 0x87, 0x1a, 0x25, 0x66, 0x24,
-	0x36, 0x68, 0xdb, 0x23, 0x30, 0xf4, 0xa6, 0xdb, 0x56, 0x56, 0xed, 0xc9, 0x6f, 0x7f, 0xff, 0xfa,
-	0x33, 0xbf, 0xff, 0xfd, 0xeb, 0xcf, 0x7c, 0xf7, 0xfb, 0xd7, 0x9f, 0xf9, 0xf2, 0xc1, 0x75, 0xeb,
-	0xdb, 0x07, 0xd7, 0xad, 0xdf, 0x3f, 0xb8, 0x6e, 0x7d, 0xf7, 0xe0, 0xba, 0xf5, 0x1f, 0x0f, 0xae,
-	0x5b, 0x7f, 0xeb, 0x3f, 0x5d, 0x7f, 0xe6, 0xcd, 0x82, 0xec, 0xdb, 0xff, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xa2, 0xee, 0x4a, 0x7c, 0x43, 0xa4, 0x00, 0x00,
+	// 9221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc9,
+	0x75, 0xd8, 0xf5, 0x90, 0x43, 0x0e, 0x8b, 0xe4, 0x92, 0xdb, 0xfb, 0xd5, 0xc7, 0xdb, 0x5b, 0xae,
+	0xfb, 0x74, 0xe7, 0x3b, 0x47, 0x22, 0x7d, 0xbb, 0x52, 0x7c, 0x91, 0x10, 0x5b, 0x1c, 0x72, 0xc9,
+	0xdd, 0xdb, 0xe5, 0xc7, 0xbd, 0xe1, 0xee, 0x46, 0x77, 0x17, 0x59, 0xcd, 0x99, 0xe2, 0x4c, 0x1f,
+	0x67, 0xba, 0xe7, 0xba, 0x7b, 0xc8, 0xe5, 0xe9, 0x4e, 0x52, 0xce, 0xb1, 0xa5, 0x8b, 0xe5, 0xd8,
+	0x49, 0x1c, 0x7f, 0x25, 0x01, 0x84, 0x38, 0x4e, 0x04, 0xc7, 0x08, 0x60, 0x20, 0xbf, 0xe2, 0xbf,
+	0x81, 0xa1, 0x20, 0x3f, 0x62, 0xc3, 0x4e, 0x2c, 0x20, 0xce, 0x2a, 0x62, 0x12, 0x20, 0x40, 0xe0,
+	0x20, 0x30, 0x22, 0xd9, 0xd8, 0x38, 0x40, 0xf0, 0xea, 0xab, 0xab, 0x7a, 0x7a, 0xb8, 0xe4, 0x6e,
+	0x73, 0xef, 0x60, 0xe7, 0xdf, 0xcc, 0xab, 0x57, 0xef, 0x55, 0x55, 0x57, 0xbd, 0x7a, 0xf5, 0xde,
+	0xab, 0x57, 0x64, 0xa3, 0xe9, 0x27, 0xad, 0xde, 0xd6, 0x5c, 0x3d, 0xec, 0xcc, 0x7b, 0x51, 0x33,
+	0xec, 0x46, 0xe1, 0x5b, 0xec, 0xc7, 0x27, 0xf6, 0xc2, 0x68, 0x67, 0xbb, 0x1d, 0xee, 0xc5, 0xf3,
+	0xbb, 0x57, 0xe7, 0xbb, 0x3b, 0xcd, 0x79, 0xaf, 0xeb, 0xc7, 0xf3, 0x12, 0x3a, 0xbf, 0xfb, 0xb2,
+	0xd7, 0xee, 0xb6, 0xbc, 0x97, 0xe7, 0x9b, 0x34, 0xa0, 0x91, 0x97, 0xd0, 0xc6, 0x5c, 0x37, 0x0a,
+	0x93, 0xd0, 0xfe, 0x6c, 0x4a, 0x71, 0x4e, 0x52, 0x64, 0x3f, 0x7e, 0x5c, 0x51, 0x9c, 0xdb, 0xbd,
+	0x3a, 0xd7, 0xdd, 0x69, 0xce, 0x21, 0xc5, 0x39, 0x09, 0x9d, 0x93, 0x14, 0x67, 0x3e, 0xa1, 0xb5,
+	0xa9, 0x19, 0x36, 0xc3, 0x79, 0x46, 0x78, 0xab, 0xb7, 0xcd, 0xfe, 0xb1, 0x3f, 0xec, 0x17, 0x67,
+	0x38, 0xe3, 0xee, 0xbc, 0x12, 0xcf, 0xf9, 0x21, 0xb6, 0x6f, 0xbe, 0x1e, 0x46, 0x74, 0x7e, 0xb7,
+	0xaf, 0x51, 0x33, 0x2f, 0x69, 0x38, 0xdd, 0xb0, 0xed, 0xd7, 0xf7, 0xe7, 0x77, 0x5f, 0xde, 0xa2,
+	0x49, 0x7f, 0xfb, 0x67, 0x3e, 0x99, 0xa2, 0x76, 0xbc, 0x7a, 0xcb, 0x0f, 0x68, 0xb4, 0x2f, 0xfb,
+	0x3f, 0x1f, 0xd1, 0x38, 0xec, 0x45, 0x75, 0x7a, 0xac, 0x5a, 0xf1, 0x7c, 0x87, 0x26, 0x5e, 0x5e,
+	0xb3, 0xe6, 0x07, 0xd5, 0x8a, 0x7a, 0x41, 0xe2, 0x77, 0xfa, 0xd9, 0xfc, 0xe5, 0x87, 0x55, 0x88,
+	0xeb, 0x2d, 0xda, 0xf1, 0xfa, 0xea, 0x5d, 0x1d, 0x54, 0xaf, 0x97, 0xf8, 0xed, 0x79, 0x3f, 0x48,
+	0xe2, 0x24, 0xca, 0x56, 0x72, 0xaf, 0x91, 0x91, 0x85, 0x4e, 0xd8, 0x0b, 0x12, 0xfb, 0x33, 0xa4,
+	0xbc, 0xeb, 0xb5, 0x7b, 0xd4, 0xb1, 0x2e, 0x5b, 0x2f, 0x8e, 0x55, 0x9f, 0xff, 0xd6, 0xfd, 0xd9,
+	0xa7, 0x0e, 0xee, 0xcf, 0x96, 0xef, 0x20, 0xf0, 0xc1, 0xfd, 0xd9, 0xb3, 0x34, 0xa8, 0x87, 0x0d,
+	0x3f, 0x68, 0xce, 0xbf, 0x15, 0x87, 0xc1, 0xdc, 0x5a, 0xaf, 0xb3, 0x45, 0x23, 0xe0, 0x75, 0xdc,
+	0xdf, 0x2b, 0x91, 0xa9, 0x85, 0xa8, 0xde, 0xf2, 0x77, 0x69, 0x2d, 0x41, 0xfa, 0xcd, 0x7d, 0xbb,
+	0x45, 0x86, 0x12, 0x2f, 0x62, 0xe4, 0xc6, 0xaf, 0xac, 0xce, 0x3d, 0xee, 0x94, 0x99, 0xdb, 0xf4,
+	0x22, 0x49, 0xbb, 0x3a, 0x7a, 0x70, 0x7f, 0x76, 0x68, 0xd3, 0x8b, 0x00, 0x59, 0xd8, 0x6d, 0x32,
+	0x1c, 0x84, 0x01, 0x75, 0x4a, 0x8c, 0xd5, 0xda, 0xe3, 0xb3, 0x5a, 0x0b, 0x03, 0xd5, 0x8f, 0x6a,
+	0xe5, 0xe0, 0xfe, 0xec, 0x30, 0x42, 0x80, 0x71, 0xc1, 0x7e, 0xbd, 0xe3, 0x77, 0x9d, 0xa1, 0xa2,
+	0xfa, 0xf5, 0xba, 0xdf, 0x35, 0xfb, 0xf5, 0xba, 0xdf, 0x05, 0x64, 0xe1, 0x7e, 0x50, 0x22, 0x63,
+	0x0b, 0x51, 0xb3, 0xd7, 0xa1, 0x41, 0x12, 0xdb, 0x5f, 0x26, 0xa4, 0xeb, 0x45, 0x5e, 0x87, 0x26,
+	0x34, 0x8a, 0x1d, 0xeb, 0xf2, 0xd0, 0x8b, 0xe3, 0x57, 0x6e, 0x3e, 0x3e, 0xfb, 0x0d, 0x49, 0xb3,
+	0x6a, 0x8b, 0x4f, 0x4e, 0x14, 0x28, 0x06, 0x8d, 0xa5, 0xfd, 0x45, 0x32, 0xe6, 0x45, 0x89, 0xbf,
+	0xed, 0xd5, 0x93, 0xd8, 0x29, 0x31, 0xfe, 0xaf, 0x3e, 0x3e, 0xff, 0x05, 0x41, 0xb2, 0x7a, 0x5a,
+	0xb0, 0x1f, 0x93, 0x90, 0x18, 0x52, 0x7e, 0xee, 0xef, 0x8d, 0x90, 0x8a, 0x2c, 0xb0, 0x2f, 0x93,
+	0xe1, 0xc0, 0xeb, 0xc8, 0xa9, 0x3a, 0x21, 0x2a, 0x0e, 0xaf, 0x79, 0x1d, 0xfc, 0x48, 0x5e, 0x87,
+	0x22, 0x46, 0xd7, 0x4b, 0x5a, 0x4e, 0xc9, 0xc4, 0xd8, 0xf0, 0x92, 0x16, 0xb0, 0x12, 0xfb, 0x22,
+	0x19, 0xee, 0x84, 0x0d, 0xca, 0xbe, 0x63, 0x99, 0x7f, 0xe4, 0xd5, 0xb0, 0x41, 0x81, 0x41, 0xb1,
+	0xfe, 0x76, 0x14, 0x76, 0x9c, 0x61, 0xb3, 0xfe, 0x72, 0x14, 0x76, 0x80, 0x95, 0xd8, 0xbf, 0x64,
+	0x91, 0x69, 0xd9, 0xbc, 0x5b, 0x61, 0xdd, 0x4b, 0xfc, 0x30, 0x70, 0xca, 0x6c, 0x52, 0x40, 0x71,
+	0xa3, 0x22, 0x29, 0x57, 0x1d, 0xd1, 0x84, 0xe9, 0x6c, 0x09, 0xf4, 0xb5, 0xc2, 0xbe, 0x42, 0x48,
+	0xb3, 0x1d, 0x6e, 0x79, 0x6d, 0x1c, 0x10, 0x67, 0x84, 0x75, 0x41, 0x7d, 0xdc, 0x15, 0x55, 0x02,
+	0x1a, 0x96, 0x7d, 0x8f, 0x8c, 0x7a, 0x7c, 0x01, 0x3b, 0xa3, 0xac, 0x13, 0xaf, 0x15, 0xd1, 0x09,
+	0x43, 0x22, 0x54, 0xc7, 0x0f, 0xee, 0xcf, 0x8e, 0x0a, 0x20, 0x48, 0x76, 0xf6, 0xc7, 0x49, 0x25,
+	0xec, 0x62, 0xbb, 0xbd, 0xb6, 0x53, 0xb9, 0x6c, 0xbd, 0x58, 0xa9, 0x4e, 0x8b, 0xb6, 0x56, 0xd6,
+	0x05, 0x1c, 0x14, 0x86, 0xfd, 0x12, 0x19, 0x8d, 0x7b, 0x5b, 0xf8, 0x1d, 0x9d, 0x31, 0xd6, 0xb1,
+	0x29, 0x81, 0x3c, 0x5a, 0xe3, 0x60, 0x90, 0xe5, 0xf6, 0xa7, 0xc8, 0x78, 0x44, 0xeb, 0xbd, 0x28,
+	0xa6, 0xf8, 0x61, 0x1d, 0xc2, 0x68, 0x9f, 0x11, 0xe8, 0xe3, 0x90, 0x16, 0x81, 0x8e, 0x67, 0xff,
+	0x28, 0x39, 0x85, 0x1f, 0xf8, 0xda, 0xbd, 0x6e, 0x44, 0xe3, 0x18, 0xbf, 0xea, 0x38, 0x63, 0x74,
+	0x5e, 0xd4, 0x3c, 0xb5, 0x6c, 0x94, 0x42, 0x06, 0xdb, 0x7e, 0x97, 0x10, 0xf9, 0x45, 0x56, 0x16,
+	0x9d, 0x09, 0x36, 0x98, 0xb7, 0x8a, 0x9b, 0x11, 0x2b, 0x8b, 0xd5, 0x53, 0xf8, 0x1d, 0xd3, 0xff,
+	0xa0, 0xf1, 0xc3, 0xd1, 0xac, 0xb7, 0x68, 0x7d, 0x27, 0xee, 0x75, 0x9c, 0x49, 0xd6, 0x6e, 0x35,
+	0x9a, 0x8b, 0x02, 0x0e, 0x0a, 0xc3, 0xdd, 0x20, 0x1a, 0x1d, 0xbb, 0x4a, 0x2a, 0xb1, 0xf8, 0x56,
+	0x62, 0x69, 0xbd, 0x20, 0xeb, 0xca, 0x6f, 0xf8, 0xe0, 0xfe, 0xac, 0x9d, 0xd6, 0x90, 0x50, 0x50,
+	0xf5, 0xdc, 0xdf, 0xa8, 0x90, 0xbe, 0x29, 0x6a, 0xbf, 0x4c, 0xc6, 0xc5, 0xd7, 0xbe, 0x15, 0x36,
+	0x63, 0x46, 0xbb, 0x52, 0x9d, 0xc2, 0xaf, 0xb0, 0x90, 0x82, 0x41, 0xc7, 0xb1, 0x1b, 0xa4, 0x14,
+	0x5f, 0x75, 0x4a, 0x45, 0x8d, 0x5e, 0xed, 0xaa, 0x92, 0x33, 0x23, 0x07, 0xf7, 0x67, 0x4b, 0xb5,
+	0xab, 0x50, 0x8a, 0xaf, 0xa2, 0x2c, 0x6f, 0xfa, 0x49, 0x71, 0xb2, 0x7c, 0xc5, 0x4f, 0x14, 0x1f,
+	0x26, 0xcb, 0x57, 0xfc, 0x04, 0x90, 0x05, 0xee, 0x51, 0xad, 0x24, 0xe9, 0x3a, 0xc3, 0x45, 0xed,
+	0x51, 0xd7, 0x37, 0x37, 0x37, 0x14, 0x2f, 0x26, 0xbe, 0x10, 0x02, 0x8c, 0x8b, 0xfd, 0x35, 0x0b,
+	0x47, 0x9c, 0x17, 0x86, 0xd1, 0xbe, 0x90, 0x4b, 0xb7, 0x8b, 0x9b, 0x85, 0x61, 0xb4, 0xaf, 0x98,
+	0x8b, 0x0f, 0xa9, 0x0a, 0x40, 0x67, 0xcd, 0x3a, 0xde, 0xd8, 0x8e, 0x9d, 0x91, 0xc2, 0x3a, 0xbe,
+	0xb4, 0x5c, 0xcb, 0x74, 0x7c, 0x69, 0xb9, 0x06, 0x8c, 0x0b, 0x7e, 0xd0, 0xc8, 0xdb, 0x73, 0x46,
+	0x8b, 0xfa, 0xa0, 0xe0, 0xed, 0x99, 0x1f, 0x14, 0xbc, 0x3d, 0x40, 0x16, 0xc8, 0x29, 0x8c, 0x63,
+	0xa7, 0x52, 0x14, 0xa7, 0xf5, 0x5a, 0xcd, 0xe4, 0xb4, 0x5e, 0xab, 0x01, 0xb2, 0x60, 0x93, 0xb4,
+	0x1e, 0x3b, 0x63, 0x45, 0x71, 0x5a, 0x59, 0xcc, 0x70, 0x5a, 0x59, 0xac, 0x01, 0xb2, 0xb0, 0xbb,
+	0xa4, 0xec, 0xbd, 0xd3, 0x8b, 0xb8, 0xac, 0x1c, 0xbf, 0xb2, 0x5e, 0xc0, 0x7c, 0x41, 0x72, 0x8a,
+	0xdb, 0x18, 0x2a, 0x94, 0x0c, 0x04, 0x9c, 0x91, 0xfb, 0x81, 0x45, 0x26, 0x65, 0x31, 0x0a, 0xed,
+	0xd8, 0xbe, 0x47, 0x2a, 0x72, 0xfa, 0x08, 0xdd, 0xb1, 0x48, 0x25, 0x43, 0x09, 0x43, 0x09, 0x01,
+	0xc5, 0xcd, 0xfd, 0xe6, 0x08, 0x51, 0xb2, 0x0d, 0x68, 0x37, 0x8c, 0x7d, 0x36, 0x81, 0x1f, 0x41,
+	0x78, 0x05, 0x9a, 0xf0, 0xba, 0x53, 0xa4, 0xf0, 0x4a, 0x9b, 0x65, 0x88, 0xb1, 0xbf, 0x9b, 0x59,
+	0xee, 0x5c, 0x9e, 0xfd, 0xf8, 0x89, 0x2c, 0x77, 0xad, 0x09, 0x87, 0x2f, 0xfc, 0x5d, 0xb1, 0xf0,
+	0xb9, 0xc4, 0xfb, 0x6b, 0xc5, 0x2e, 0x7c, 0xad, 0x15, 0x59, 0x11, 0x10, 0xf1, 0x85, 0xc9, 0x45,
+	0xde, 0xdd, 0x42, 0x17, 0xa6, 0xc6, 0xd5, 0x5c, 0xa2, 0x11, 0x5f, 0xa2, 0x23, 0x45, 0xf1, 0x5c,
+	0x59, 0x1c, 0xc8, 0x53, 0x2d, 0xd6, 0x77, 0xe4, 0x62, 0xe5, 0xc2, 0xee, 0x73, 0x05, 0x2f, 0x56,
+	0x8d, 0x6f, 0xff, 0xb2, 0x7d, 0x9b, 0x9c, 0xeb, 0xc7, 0x03, 0xba, 0x6d, 0xcf, 0x93, 0xb1, 0x7a,
+	0x18, 0x6c, 0xfb, 0xcd, 0x55, 0xaf, 0x2b, 0x74, 0x08, 0xa5, 0xd7, 0x2f, 0xca, 0x02, 0x48, 0x71,
+	0xec, 0x67, 0xc9, 0xd0, 0x0e, 0xdd, 0x17, 0x7a, 0xfa, 0xb8, 0x40, 0x1d, 0xba, 0x49, 0xf7, 0x01,
+	0xe1, 0x9f, 0xae, 0xfc, 0xd2, 0x37, 0x66, 0x9f, 0xfa, 0xca, 0x1f, 0x5e, 0x7e, 0xca, 0xfd, 0xdd,
+	0x21, 0xf2, 0x4c, 0x2e, 0xcf, 0x5a, 0xe2, 0x25, 0xbd, 0xd8, 0xfe, 0x0d, 0x8b, 0x9c, 0xf3, 0xf2,
+	0xca, 0x1d, 0xab, 0xa8, 0xaf, 0x92, 0xcb, 0xbe, 0xfa, 0xac, 0x68, 0x74, 0xfe, 0x88, 0xc0, 0x39,
+	0x6f, 0xd0, 0x40, 0xe1, 0x41, 0x25, 0xee, 0x7a, 0x75, 0xea, 0x94, 0xcc, 0x81, 0x5a, 0x93, 0x05,
+	0x90, 0xe2, 0xa0, 0xe2, 0xdb, 0xa0, 0xdb, 0x5e, 0xaf, 0xcd, 0xd5, 0x95, 0x4a, 0xaa, 0xf8, 0x2e,
+	0x71, 0x30, 0xc8, 0x72, 0xfb, 0x1f, 0x5a, 0xc4, 0xee, 0xe7, 0x2a, 0x16, 0xe2, 0xe6, 0x49, 0x8c,
+	0x43, 0xf5, 0xfc, 0x81, 0xa6, 0x18, 0x6a, 0x3d, 0xcd, 0x69, 0x87, 0xf6, 0x4d, 0xff, 0xad, 0x45,
+	0xce, 0xe4, 0x88, 0x18, 0x9c, 0x14, 0xbd, 0xa8, 0xed, 0x58, 0xe6, 0xa4, 0xb8, 0x0d, 0xb7, 0x00,
+	0xe1, 0xf6, 0xcf, 0x5b, 0x64, 0x4a, 0x93, 0x34, 0x0b, 0x3d, 0x71, 0xd0, 0x2b, 0xe8, 0xd0, 0x62,
+	0x10, 0xae, 0x5e, 0x10, 0xec, 0xa7, 0x32, 0x05, 0x90, 0x6d, 0x82, 0xfb, 0x5d, 0x8b, 0x3c, 0x7b,
+	0xa8, 0xc0, 0xcc, 0x6d, 0xb8, 0xf5, 0xa1, 0x37, 0x1c, 0xa7, 0x56, 0x44, 0xbb, 0xe1, 0x6d, 0xb8,
+	0x25, 0x66, 0xa2, 0x9a, 0x5a, 0xc0, 0xc1, 0x20, 0xcb, 0xdd, 0x3f, 0xb0, 0x48, 0x96, 0x9e, 0xed,
+	0x91, 0x53, 0xbd, 0x98, 0x46, 0x38, 0x55, 0x6b, 0xb4, 0x1e, 0x51, 0xb9, 0x6f, 0x3f, 0x3f, 0xc7,
+	0x2d, 0x52, 0xd8, 0xe0, 0xb9, 0x7a, 0x18, 0xd1, 0xb9, 0xdd, 0x97, 0xe7, 0x38, 0xc6, 0x4d, 0xba,
+	0x5f, 0xa3, 0x6d, 0x8a, 0x34, 0xaa, 0x36, 0x9e, 0xa9, 0x6e, 0x1b, 0x04, 0x20, 0x43, 0x10, 0x59,
+	0x74, 0xbd, 0x38, 0xde, 0x0b, 0xa3, 0x86, 0x60, 0x51, 0x3a, 0x36, 0x8b, 0x0d, 0x83, 0x00, 0x64,
+	0x08, 0xba, 0xbf, 0x8f, 0x9a, 0x88, 0x2e, 0x00, 0xed, 0x6f, 0xe0, 0x32, 0x42, 0x48, 0xb5, 0x1d,
+	0x6e, 0x2d, 0x86, 0x41, 0xe2, 0xa1, 0x4d, 0xcd, 0xb1, 0x0a, 0x5b, 0x46, 0x7d, 0xb4, 0xab, 0x33,
+	0x62, 0xe0, 0xed, 0xfe, 0x32, 0xc8, 0x69, 0x0b, 0x9a, 0x29, 0xb6, 0xda, 0xe1, 0x56, 0xd6, 0xcc,
+	0x81, 0x48, 0xc0, 0x4a, 0xdc, 0x3f, 0xb6, 0xc8, 0x85, 0x01, 0x72, 0xdd, 0xfe, 0x05, 0x8b, 0x4c,
+	0x6e, 0x7d, 0x24, 0xfa, 0x66, 0x36, 0x03, 0x8f, 0xe0, 0x08, 0x40, 0x39, 0xb8, 0x1c, 0x46, 0x1d,
+	0x2f, 0x71, 0x4a, 0xe6, 0x11, 0xbc, 0x6a, 0x94, 0x42, 0x06, 0xdb, 0xfd, 0x7b, 0x25, 0x92, 0xc3,
+	0x05, 0xcf, 0xc6, 0x34, 0x68, 0x74, 0x43, 0x3f, 0x48, 0x84, 0x6c, 0x51, 0xea, 0xe0, 0x35, 0x01,
+	0x07, 0x85, 0x21, 0xb6, 0x32, 0x31, 0x30, 0xa5, 0xbe, 0xad, 0x4c, 0xb4, 0x3c, 0xc5, 0xb1, 0x9b,
+	0x64, 0xda, 0xab, 0xd7, 0xd1, 0x98, 0xca, 0xe6, 0x1e, 0x9b, 0xa6, 0x43, 0xc7, 0x99, 0xa6, 0x67,
+	0x99, 0x7d, 0x27, 0x43, 0x02, 0xfa, 0x88, 0xa2, 0x61, 0xa3, 0x17, 0xd3, 0xda, 0xd2, 0xcd, 0xc5,
+	0x88, 0x36, 0xb8, 0x82, 0xa5, 0x19, 0x36, 0x6e, 0xa7, 0x45, 0xa0, 0xe3, 0xb9, 0xff, 0xda, 0x22,
+	0xa3, 0x55, 0xaf, 0xbe, 0x13, 0x6e, 0x6f, 0xe3, 0x50, 0x34, 0x7a, 0x11, 0x37, 0x5a, 0x65, 0x86,
+	0x62, 0x49, 0xc0, 0x41, 0x61, 0xd8, 0x9b, 0x64, 0x84, 0x2f, 0x78, 0xb1, 0xec, 0x7e, 0x58, 0xeb,
+	0x8f, 0xb2, 0x35, 0xb3, 0xe9, 0x80, 0xb6, 0xe6, 0x39, 0x6e, 0x6b, 0x9e, 0xbb, 0x11, 0x24, 0xeb,
+	0x68, 0xb2, 0xf5, 0x83, 0x66, 0x95, 0x1c, 0xdc, 0x9f, 0x1d, 0x59, 0x66, 0x34, 0x40, 0xd0, 0xc2,
+	0x6e, 0x74, 0xbc, 0x7b, 0x92, 0x1d, 0x1b, 0xaa, 0xb1, 0xb4, 0x1b, 0xab, 0x69, 0x11, 0xe8, 0x78,
+	0xee, 0xef, 0x5a, 0x64, 0xac, 0xea, 0xc5, 0x7e, 0xfd, 0xcf, 0x91, 0xf0, 0xf9, 0x3c, 0x29, 0x2f,
+	0x7a, 0xf5, 0x16, 0xb5, 0x6f, 0x67, 0xf5, 0xa7, 0xf1, 0x2b, 0x2f, 0xe6, 0xb1, 0x51, 0xba, 0x94,
+	0xce, 0x69, 0x72, 0x90, 0x96, 0xe5, 0x7e, 0xcf, 0x22, 0x17, 0x16, 0xdb, 0xbd, 0x38, 0xa1, 0xd1,
+	0x5d, 0xb1, 0x56, 0x37, 0x69, 0xa7, 0xdb, 0xf6, 0x12, 0x6a, 0x7f, 0x81, 0x54, 0xd0, 0x77, 0xd1,
+	0xf0, 0x12, 0xcf, 0xb1, 0x1e, 0xf2, 0x79, 0xd9, 0x6a, 0x47, 0x6c, 0x6c, 0xc3, 0xfa, 0xd6, 0x5b,
+	0xb4, 0x9e, 0xac, 0xd2, 0xc4, 0x4b, 0xad, 0x8b, 0x29, 0x0c, 0x14, 0x55, 0xfb, 0x1e, 0x19, 0x8e,
+	0xbb, 0xb4, 0x5e, 0xdc, 0x81, 0x28, 0xdb, 0x87, 0x5a, 0x97, 0xd6, 0x53, 0xe9, 0x87, 0xff, 0x80,
+	0x71, 0x74, 0xff, 0x8f, 0x45, 0x9e, 0x19, 0xd0, 0xef, 0x5b, 0x7e, 0x9c, 0xd8, 0x6f, 0xf6, 0xf5,
+	0x7d, 0xee, 0x68, 0x7d, 0xc7, 0xda, 0xac, 0xe7, 0x6a, 0xd9, 0x48, 0x88, 0xd6, 0xef, 0x2f, 0x91,
+	0xb2, 0x9f, 0xd0, 0x8e, 0x34, 0x96, 0x17, 0xa0, 0xa1, 0x0f, 0xe8, 0x4b, 0x75, 0x52, 0x7a, 0x6b,
+	0x6e, 0x20, 0x3f, 0xe0, 0x6c, 0xdd, 0x7f, 0x63, 0x11, 0x9c, 0x0e, 0x0d, 0x5f, 0x18, 0xe1, 0x86,
+	0x93, 0xfd, 0xae, 0x34, 0x9a, 0x4b, 0xad, 0x75, 0x78, 0x73, 0xbf, 0x8b, 0xee, 0x9d, 0x49, 0x85,
+	0x88, 0x00, 0x60, 0xa8, 0xf6, 0xe7, 0xc9, 0x48, 0xcc, 0xb4, 0x6b, 0x21, 0xff, 0x96, 0x45, 0xa5,
+	0x11, 0xae, 0x73, 0x3f, 0xb8, 0x3f, 0x7b, 0x24, 0x9f, 0xd8, 0x9c, 0xa2, 0xcd, 0xeb, 0x81, 0xa0,
+	0x8a, 0x8a, 0x47, 0x87, 0xc6, 0xb1, 0xd7, 0xa4, 0xce, 0x90, 0xa9, 0x78, 0xac, 0x72, 0x30, 0xc8,
+	0x72, 0xf7, 0xef, 0x5b, 0x64, 0x52, 0x49, 0xdd, 0x35, 0xb4, 0xd3, 0xae, 0xe9, 0xf2, 0x99, 0x7f,
+	0xbc, 0x67, 0x07, 0x2c, 0x15, 0xb1, 0x03, 0x1d, 0x2e, 0xbe, 0x3f, 0x49, 0x26, 0x1a, 0xb4, 0x4b,
+	0x83, 0x06, 0x0d, 0xea, 0x3e, 0xe5, 0x1f, 0x6d, 0xac, 0x3a, 0x7d, 0x70, 0x7f, 0x76, 0x62, 0x49,
+	0x83, 0x83, 0x81, 0xe5, 0xfe, 0x89, 0x45, 0xce, 0x2a, 0x72, 0x35, 0x9a, 0xa8, 0x65, 0xf5, 0x13,
+	0x16, 0x21, 0x8a, 0x38, 0x0a, 0xe9, 0xa1, 0x62, 0x2c, 0x2a, 0xc6, 0x20, 0xa4, 0x0b, 0x4f, 0x81,
+	0x63, 0xd0, 0xd8, 0xda, 0x9f, 0x23, 0x13, 0xbb, 0x61, 0xbb, 0xd7, 0xa1, 0xab, 0xb8, 0x85, 0xc4,
+	0xce, 0x10, 0x6b, 0xc6, 0x6c, 0xde, 0x38, 0xdd, 0x49, 0xf1, 0xaa, 0x67, 0x05, 0xd9, 0x09, 0x0d,
+	0x18, 0x83, 0x41, 0xca, 0xfd, 0x1c, 0x61, 0x4c, 0xfd, 0xa0, 0x47, 0xd7, 0x03, 0xfb, 0x39, 0x52,
+	0xa6, 0x51, 0x14, 0x46, 0xc2, 0x3e, 0xa2, 0x26, 0xe4, 0x35, 0x04, 0x02, 0x2f, 0xb3, 0x5f, 0xc0,
+	0x7d, 0xc4, 0x6f, 0xd3, 0x06, 0x9b, 0x4f, 0x95, 0xea, 0x29, 0x39, 0x9f, 0x96, 0x19, 0x14, 0x44,
+	0xa9, 0x3b, 0x47, 0x46, 0x17, 0x91, 0x09, 0x8d, 0x90, 0xae, 0xee, 0x96, 0x9c, 0x34, 0xdc, 0x92,
+	0xd2, 0xfd, 0xb8, 0x49, 0xce, 0x2d, 0x46, 0x14, 0x05, 0xc1, 0xd5, 0x6a, 0xaf, 0xbe, 0x43, 0x13,
+	0xee, 0x38, 0x88, 0xed, 0xcf, 0x90, 0xc9, 0x90, 0x49, 0xa4, 0x5b, 0x61, 0x7d, 0xc7, 0x0f, 0x9a,
+	0xe2, 0xe8, 0x74, 0x4e, 0x50, 0x99, 0x5c, 0xd7, 0x0b, 0xc1, 0xc4, 0x75, 0xff, 0x6b, 0x89, 0x4c,
+	0x2c, 0x46, 0x61, 0x20, 0x57, 0xdb, 0x13, 0x90, 0x94, 0x89, 0x21, 0x29, 0x0b, 0xf0, 0x23, 0xe9,
+	0xed, 0x1f, 0x24, 0x25, 0xed, 0x77, 0xd5, 0x32, 0x1f, 0x2a, 0x4a, 0xff, 0x33, 0xf8, 0x32, 0xda,
+	0xe9, 0xc7, 0x36, 0x85, 0x80, 0xfb, 0xdf, 0x2c, 0x32, 0xad, 0xa3, 0x3f, 0x01, 0xc1, 0x1c, 0x9b,
+	0x82, 0x79, 0xad, 0xd8, 0xfe, 0x0e, 0x90, 0xc6, 0x1f, 0x8c, 0x98, 0xfd, 0xc4, 0x0f, 0x80, 0x5e,
+	0xc4, 0x89, 0x3d, 0x0d, 0x20, 0x3a, 0xbb, 0x56, 0xdc, 0x1e, 0xc9, 0xbe, 0xfa, 0xc7, 0xe4, 0x7a,
+	0xd6, 0xa1, 0x0f, 0x32, 0xff, 0xc1, 0x68, 0x09, 0xaa, 0x88, 0x18, 0x69, 0xd0, 0xe8, 0xb5, 0xa5,
+	0x81, 0x42, 0x0d, 0x69, 0x4d, 0xc0, 0x41, 0x61, 0xd8, 0x6f, 0x92, 0xd3, 0xf5, 0x30, 0xa8, 0xf7,
+	0xa2, 0x88, 0x06, 0xf5, 0xfd, 0x0d, 0x16, 0x7f, 0x21, 0x84, 0xfa, 0x9c, 0xa8, 0x76, 0x7a, 0x31,
+	0x8b, 0xf0, 0x20, 0x0f, 0x08, 0xfd, 0x84, 0xb8, 0xd7, 0x2f, 0x46, 0xb1, 0x2b, 0xb4, 0x5d, 0xcd,
+	0xeb, 0xc7, 0xc0, 0x20, 0xcb, 0xed, 0xdb, 0xe4, 0x42, 0x9c, 0xe0, 0x09, 0x37, 0x68, 0x2e, 0x51,
+	0xaf, 0xd1, 0xf6, 0x03, 0xd4, 0xe3, 0xc2, 0xa0, 0xc1, 0x4d, 0x82, 0x43, 0xd5, 0x67, 0x0e, 0xee,
+	0xcf, 0x5e, 0xa8, 0xe5, 0xa3, 0xc0, 0xa0, 0xba, 0xf6, 0xe7, 0xc9, 0x4c, 0xdc, 0xab, 0xd7, 0x69,
+	0x1c, 0x6f, 0xf7, 0xda, 0xaf, 0x86, 0x5b, 0xf1, 0x75, 0x3f, 0xc6, 0x43, 0xd4, 0x2d, 0xbf, 0xe3,
+	0x27, 0xcc, 0xf0, 0x57, 0xae, 0x5e, 0x3a, 0xb8, 0x3f, 0x3b, 0x53, 0x1b, 0x88, 0x05, 0x87, 0x50,
+	0xb0, 0x81, 0x9c, 0xe7, 0xc2, 0xaf, 0x8f, 0xf6, 0x28, 0xa3, 0x3d, 0x73, 0x70, 0x7f, 0xf6, 0xfc,
+	0x72, 0x2e, 0x06, 0x0c, 0xa8, 0x89, 0x5f, 0x10, 0x03, 0x46, 0xde, 0xc1, 0xd8, 0x88, 0x8a, 0xf9,
+	0x05, 0x37, 0x05, 0x1c, 0x14, 0x86, 0xfd, 0x56, 0x3a, 0x13, 0x71, 0xb9, 0x38, 0x63, 0x8f, 0x28,
+	0xe1, 0xd8, 0x29, 0xe6, 0xae, 0x46, 0x09, 0x97, 0x1c, 0x18, 0xb4, 0x31, 0x5e, 0xc4, 0xee, 0x17,
+	0x11, 0xf6, 0x4d, 0x32, 0xe2, 0xd5, 0x13, 0xf4, 0x41, 0xf3, 0xf0, 0x86, 0xe7, 0xf2, 0xf6, 0x29,
+	0xce, 0x0a, 0xe8, 0x36, 0xc5, 0x19, 0x42, 0x53, 0xb9, 0xb2, 0xc0, 0xaa, 0x82, 0x20, 0x61, 0x87,
+	0xe4, 0x74, 0xdb, 0x8b, 0x13, 0x39, 0x57, 0x1b, 0xd8, 0x65, 0x21, 0x58, 0x7f, 0xe8, 0x68, 0x9d,
+	0xc2, 0x1a, 0xd5, 0x73, 0x38, 0x73, 0x6f, 0x65, 0x09, 0x41, 0x3f, 0x6d, 0x0c, 0xd0, 0xa8, 0x4b,
+	0x45, 0x47, 0xee, 0xb4, 0x37, 0x0b, 0xd9, 0xf0, 0x39, 0x4d, 0x63, 0xb3, 0x17, 0x6c, 0x40, 0x63,
+	0xe9, 0xfe, 0xe1, 0x18, 0x19, 0x5d, 0x5a, 0x58, 0xd9, 0xf4, 0xe2, 0x9d, 0x23, 0x84, 0x48, 0xe0,
+	0xec, 0x10, 0xca, 0x4a, 0x76, 0x7d, 0x4b, 0x25, 0x06, 0x14, 0x86, 0xfd, 0x2e, 0x06, 0x7f, 0x88,
+	0x50, 0x14, 0xb1, 0x4d, 0xdc, 0x2c, 0xc2, 0x66, 0x25, 0x48, 0xea, 0xd1, 0x1f, 0x02, 0x04, 0x29,
+	0x43, 0xfb, 0x2b, 0x16, 0x19, 0x97, 0x4d, 0x41, 0x93, 0xee, 0x70, 0x61, 0x41, 0x45, 0x29, 0x51,
+	0xee, 0xce, 0xd0, 0x00, 0xa0, 0xb3, 0xec, 0x53, 0x0f, 0xcb, 0x47, 0x51, 0x0f, 0xed, 0x3d, 0x32,
+	0xb6, 0xe7, 0x27, 0x2d, 0xb6, 0x11, 0x38, 0x23, 0x6c, 0x4a, 0x2c, 0x3f, 0x7e, 0xab, 0x91, 0x5c,
+	0x3a, 0x62, 0x77, 0x25, 0x03, 0x48, 0x79, 0xa1, 0xf5, 0x02, 0xff, 0xb0, 0x50, 0x1e, 0x67, 0xd4,
+	0xb4, 0x5e, 0xdc, 0x95, 0x05, 0x90, 0xe2, 0xe0, 0x10, 0x4f, 0xe0, 0xbf, 0x1a, 0x7d, 0xbb, 0x87,
+	0xeb, 0xca, 0xa9, 0x14, 0xe5, 0x7c, 0x93, 0x14, 0xf9, 0x60, 0xdd, 0xd5, 0x78, 0x80, 0xc1, 0x11,
+	0xe7, 0xec, 0x5e, 0x8b, 0x06, 0xce, 0x98, 0x39, 0x67, 0xef, 0xb6, 0x68, 0x00, 0xac, 0x04, 0x63,
+	0x2b, 0xea, 0x4a, 0xe7, 0x74, 0x48, 0x51, 0xd1, 0x01, 0xa9, 0x1e, 0xcb, 0x63, 0x2b, 0xd2, 0xff,
+	0xa0, 0xf1, 0x43, 0xf5, 0x35, 0x0c, 0xae, 0xdd, 0xf3, 0x13, 0x11, 0x11, 0xa2, 0x24, 0xcf, 0x3a,
+	0x83, 0x82, 0x28, 0xe5, 0xa6, 0x7a, 0x9c, 0x04, 0xb1, 0x33, 0x61, 0x1e, 0x6b, 0xf8, 0x4c, 0x89,
+	0x41, 0x96, 0xdb, 0xff, 0xc8, 0x22, 0xe5, 0x56, 0x18, 0xee, 0xc4, 0xce, 0xe4, 0xe5, 0xa1, 0x62,
+	0x54, 0x2f, 0x21, 0x01, 0xe6, 0xae, 0x23, 0xd9, 0x6b, 0x41, 0x12, 0xed, 0x57, 0x5f, 0x96, 0x0a,
+	0x09, 0x83, 0x3d, 0xb8, 0x3f, 0x7b, 0xea, 0x96, 0xbf, 0x4d, 0xeb, 0xfb, 0xf5, 0x36, 0x65, 0x90,
+	0xf7, 0xbf, 0xa3, 0x41, 0xae, 0xed, 0xd2, 0x20, 0x01, 0xde, 0xaa, 0x99, 0x0f, 0x2c, 0x42, 0x52,
+	0x42, 0xf6, 0x34, 0xf7, 0xd6, 0x30, 0xa1, 0xc2, 0x1c, 0x34, 0x36, 0x95, 0xfa, 0x79, 0xa9, 0x28,
+	0x97, 0xb1, 0xd1, 0x34, 0xa1, 0xe1, 0x7f, 0xba, 0xf4, 0x8a, 0xe5, 0xfe, 0x3b, 0x8b, 0x8c, 0x63,
+	0xe7, 0xa4, 0x48, 0x7a, 0x81, 0x8c, 0x24, 0x5e, 0xd4, 0xa4, 0xd2, 0x98, 0xa7, 0x3e, 0xc7, 0x26,
+	0x83, 0x82, 0x28, 0xb5, 0x03, 0x52, 0x4e, 0xbc, 0x78, 0x47, 0x6a, 0x7b, 0x37, 0x0a, 0x1b, 0xe2,
+	0x54, 0xd1, 0xc3, 0x7f, 0x31, 0x70, 0x36, 0xf6, 0x8b, 0xa4, 0x82, 0x1b, 0xf2, 0xb2, 0x17, 0x4b,
+	0x57, 0xcd, 0x04, 0x0a, 0xd5, 0x65, 0x01, 0x03, 0x55, 0x8a, 0x76, 0xca, 0xe1, 0x25, 0xae, 0xf7,
+	0x8f, 0xf0, 0xa0, 0x53, 0xc7, 0x2a, 0x6a, 0x4e, 0x23, 0xdd, 0x1a, 0xa3, 0xa9, 0x69, 0xde, 0xec,
+	0x3f, 0x08, 0x5e, 0xe8, 0x8e, 0x38, 0x95, 0x44, 0x5e, 0x10, 0x6f, 0x33, 0xb3, 0x29, 0x1a, 0xe1,
+	0x4a, 0x45, 0xcd, 0xc2, 0x4d, 0x83, 0x6e, 0x2d, 0xa1, 0xdd, 0xd4, 0x7a, 0x6b, 0x96, 0x41, 0xa6,
+	0x0d, 0xee, 0x2f, 0x5a, 0x84, 0xa4, 0xad, 0xc7, 0x58, 0x96, 0x49, 0x4f, 0x0f, 0x11, 0x70, 0xac,
+	0xa2, 0xa6, 0x9a, 0x11, 0x79, 0x50, 0x3d, 0x8d, 0x27, 0x42, 0x03, 0x04, 0x26, 0x63, 0xf7, 0x53,
+	0xa4, 0xcc, 0x56, 0x07, 0xd3, 0x8d, 0x85, 0xd5, 0x2d, 0x6b, 0x3e, 0x95, 0xd6, 0x38, 0x50, 0x18,
+	0xee, 0x9b, 0xe4, 0xd4, 0xb5, 0x7b, 0xb4, 0xde, 0x4b, 0xc2, 0x88, 0x5b, 0xe7, 0xec, 0x57, 0x89,
+	0x1d, 0xd3, 0x68, 0xd7, 0xaf, 0x53, 0x61, 0xee, 0x5d, 0x4b, 0xf7, 0x6a, 0x65, 0x27, 0xaf, 0xf5,
+	0x61, 0x40, 0x4e, 0x2d, 0xf7, 0xd7, 0x2d, 0x32, 0xae, 0xf9, 0x8b, 0x71, 0xa7, 0x6e, 0x2e, 0xd6,
+	0xf8, 0x39, 0xd8, 0xb1, 0x8a, 0xda, 0xa9, 0x57, 0x24, 0xc9, 0x74, 0x1b, 0x51, 0x20, 0x48, 0x19,
+	0x3e, 0xc4, 0x9f, 0xeb, 0xfe, 0xb6, 0x45, 0xce, 0xe5, 0x3a, 0xb7, 0x3f, 0xe4, 0x66, 0xcf, 0x93,
+	0xb1, 0x1d, 0xba, 0x6f, 0x38, 0x1b, 0x54, 0x85, 0x9b, 0xb2, 0x00, 0x52, 0x1c, 0xf7, 0x37, 0x2d,
+	0x92, 0x52, 0x42, 0x51, 0xb4, 0x95, 0xb6, 0x5c, 0x13, 0x45, 0x82, 0x93, 0x28, 0xb5, 0xdf, 0x25,
+	0x17, 0xcc, 0x2f, 0x98, 0x7a, 0x0a, 0x8e, 0x65, 0x53, 0xe6, 0x67, 0x98, 0x7c, 0x4a, 0x30, 0x88,
+	0x85, 0x7b, 0x87, 0x94, 0x57, 0xbc, 0x5e, 0x93, 0x1e, 0xc9, 0xa8, 0x82, 0x62, 0x2c, 0xa2, 0x5e,
+	0x3b, 0x91, 0x6a, 0xb3, 0x10, 0x63, 0x20, 0x60, 0xa0, 0x4a, 0xdd, 0xef, 0x0d, 0x93, 0x71, 0x2d,
+	0xf2, 0x0d, 0xf7, 0xf1, 0x88, 0x76, 0xc3, 0xac, 0xee, 0x89, 0x1f, 0x1b, 0x58, 0x09, 0xae, 0x9f,
+	0x88, 0xee, 0xfa, 0x31, 0x17, 0x39, 0xc6, 0xfa, 0x01, 0x01, 0x07, 0x85, 0x61, 0xcf, 0x92, 0x72,
+	0x83, 0x76, 0x93, 0x16, 0x93, 0xa6, 0xc3, 0x3c, 0x1c, 0x61, 0x09, 0x01, 0xc0, 0xe1, 0x88, 0xb0,
+	0x4d, 0x93, 0x7a, 0x8b, 0x59, 0xd9, 0xc6, 0x38, 0xc2, 0x32, 0x02, 0x80, 0xc3, 0x73, 0xbc, 0x04,
+	0xe5, 0x93, 0xf7, 0x12, 0x8c, 0x14, 0xec, 0x25, 0xb0, 0xbb, 0xe4, 0x4c, 0x1c, 0xb7, 0x36, 0x22,
+	0x7f, 0xd7, 0x4b, 0x68, 0x3a, 0x73, 0x46, 0x8f, 0xc3, 0xe7, 0xc2, 0xc1, 0xfd, 0xd9, 0x33, 0xb5,
+	0xda, 0xf5, 0x2c, 0x15, 0xc8, 0x23, 0x6d, 0xd7, 0xc8, 0x39, 0x3f, 0x88, 0x69, 0xbd, 0x17, 0xd1,
+	0x1b, 0xcd, 0x20, 0x8c, 0xe8, 0xf5, 0x30, 0x46, 0x72, 0x22, 0x50, 0x57, 0x85, 0x3e, 0xdc, 0xc8,
+	0x43, 0x82, 0xfc, 0xba, 0xf6, 0x0a, 0x39, 0xdd, 0xf0, 0x63, 0x6f, 0xab, 0x4d, 0x6b, 0xbd, 0xad,
+	0x4e, 0x88, 0x07, 0x28, 0x1e, 0xdd, 0x56, 0xa9, 0x3e, 0x2d, 0x4d, 0x05, 0x4b, 0x59, 0x04, 0xe8,
+	0xaf, 0xe3, 0x7e, 0xdb, 0x22, 0x13, 0x7a, 0x50, 0x10, 0xea, 0xb0, 0xa4, 0xb5, 0xb4, 0x5c, 0xe3,
+	0x52, 0xb6, 0xb8, 0xbd, 0xf4, 0xba, 0xa2, 0x99, 0x9e, 0xc1, 0x52, 0x18, 0x68, 0x3c, 0x8f, 0x10,
+	0x78, 0xfe, 0x1c, 0x29, 0x6f, 0x87, 0xb8, 0xd5, 0x0f, 0x99, 0x96, 0xd2, 0x65, 0x04, 0x02, 0x2f,
+	0x73, 0xff, 0xb7, 0x45, 0xce, 0xe7, 0xc7, 0x3b, 0x7d, 0x14, 0x3a, 0x79, 0x05, 0xaf, 0x22, 0x24,
+	0x2d, 0x43, 0x5c, 0x6a, 0xb7, 0x07, 0x64, 0x09, 0x68, 0x58, 0x47, 0xeb, 0xf6, 0xf7, 0x51, 0xdd,
+	0x4c, 0xf9, 0x7c, 0xdd, 0x22, 0x93, 0xc8, 0xf6, 0x66, 0xb4, 0x65, 0xf4, 0x76, 0xbd, 0x98, 0xde,
+	0x2a, 0xb2, 0xa9, 0x41, 0xd8, 0x00, 0x83, 0xc9, 0xdc, 0xfe, 0x4b, 0x64, 0xcc, 0x6b, 0x34, 0x22,
+	0x1a, 0xc7, 0xca, 0x3d, 0xc0, 0x5c, 0x6e, 0x0b, 0x12, 0x08, 0x69, 0x39, 0x8a, 0x38, 0x0c, 0x47,
+	0x43, 0xa9, 0xe1, 0x0c, 0x99, 0x22, 0x0e, 0x99, 0x20, 0x1c, 0x14, 0x86, 0xfb, 0x33, 0xc3, 0xc4,
+	0xe4, 0x6d, 0x37, 0xc8, 0xd4, 0x4e, 0xb4, 0xb5, 0xc8, 0xdc, 0x82, 0x8f, 0xe2, 0xd9, 0x3c, 0x83,
+	0xa1, 0x1f, 0x37, 0x4d, 0x0a, 0x90, 0x25, 0x29, 0xb8, 0xdc, 0xa4, 0xfb, 0x89, 0xb7, 0xf5, 0x28,
+	0x1b, 0x91, 0xe4, 0xa2, 0x53, 0x80, 0x2c, 0x49, 0xf4, 0xf4, 0xee, 0x44, 0x5b, 0x52, 0x80, 0x66,
+	0x3d, 0xbd, 0x37, 0xd3, 0x22, 0xd0, 0xf1, 0x70, 0x08, 0x77, 0xa2, 0x2d, 0xdc, 0x70, 0xe4, 0x45,
+	0x0c, 0x35, 0x84, 0x37, 0x05, 0x1c, 0x14, 0x86, 0xdd, 0x25, 0xf6, 0x8e, 0x1c, 0x3d, 0xe5, 0x04,
+	0x75, 0xca, 0xc7, 0xf4, 0xa1, 0xb2, 0x40, 0xa6, 0x9b, 0x7d, 0x74, 0x20, 0x87, 0xb6, 0xfd, 0x39,
+	0x72, 0x61, 0x27, 0xda, 0x12, 0xdb, 0xf0, 0x46, 0xe4, 0x07, 0x75, 0xbf, 0x6b, 0x5c, 0xba, 0x98,
+	0x15, 0xcd, 0xbd, 0x70, 0x33, 0x1f, 0x0d, 0x06, 0xd5, 0x77, 0xbf, 0x51, 0x22, 0x2c, 0x9e, 0x1b,
+	0x35, 0x8b, 0x0e, 0x4d, 0x5a, 0x61, 0x23, 0xab, 0x59, 0xac, 0x32, 0x28, 0x88, 0x52, 0x19, 0x32,
+	0x55, 0x1a, 0x10, 0x32, 0xb5, 0x47, 0x46, 0x5b, 0xd4, 0x6b, 0xd0, 0x48, 0x1a, 0xa6, 0x6e, 0x15,
+	0x13, 0x81, 0x7e, 0x9d, 0x11, 0x4d, 0x0f, 0xb8, 0xfc, 0x7f, 0x0c, 0x92, 0x9b, 0xfd, 0x69, 0x72,
+	0x0a, 0x75, 0x84, 0xb0, 0x97, 0x48, 0x2b, 0xec, 0x30, 0xb3, 0xc2, 0xb2, 0xfd, 0x6e, 0xd3, 0x28,
+	0x81, 0x0c, 0x26, 0x5e, 0xd1, 0xd9, 0x0a, 0x1b, 0x3c, 0x7a, 0x7d, 0x82, 0xc7, 0x79, 0x56, 0xc3,
+	0xc6, 0x3e, 0x30, 0xa8, 0xfb, 0xf5, 0x12, 0x99, 0xd0, 0x83, 0xe0, 0x1f, 0x16, 0x35, 0x16, 0xa7,
+	0x43, 0xc0, 0x4f, 0x39, 0xd7, 0x0b, 0x18, 0x82, 0x87, 0x75, 0xbf, 0x45, 0x86, 0xbd, 0x9e, 0xd0,
+	0x5c, 0x0a, 0x31, 0xa6, 0xb0, 0x1e, 0x63, 0x78, 0x17, 0x1b, 0x0e, 0xfc, 0x05, 0x8c, 0x83, 0xfb,
+	0xbf, 0x2c, 0x52, 0x91, 0x85, 0xf6, 0x3d, 0x32, 0xb6, 0x25, 0x43, 0x24, 0x8a, 0x53, 0xa6, 0x55,
+	0xd4, 0x05, 0x17, 0x7b, 0xea, 0x2f, 0xa4, 0xcc, 0xec, 0xb7, 0xc8, 0xe9, 0x2d, 0xea, 0x45, 0x34,
+	0xda, 0x0c, 0x77, 0x68, 0xf0, 0x28, 0x22, 0x85, 0x19, 0x5c, 0xab, 0x59, 0x1a, 0xd0, 0x4f, 0x16,
+	0x43, 0xb6, 0x48, 0x3a, 0x09, 0x8f, 0x60, 0xf2, 0x7c, 0x4e, 0x37, 0x56, 0x0c, 0xd2, 0x7b, 0xbf,
+	0x4c, 0xc6, 0xd8, 0x0f, 0xbc, 0xe6, 0xe3, 0x0c, 0x15, 0xe5, 0x88, 0x4b, 0xdb, 0x29, 0x0e, 0xe5,
+	0x6c, 0x08, 0xef, 0x48, 0x46, 0x90, 0xf2, 0x74, 0x43, 0x32, 0x9d, 0xc5, 0xb6, 0xdf, 0x20, 0x13,
+	0xb1, 0x1c, 0xa9, 0x34, 0xa6, 0xf5, 0x88, 0x23, 0xca, 0xec, 0x6e, 0x35, 0xad, 0x3a, 0x18, 0xc4,
+	0xdc, 0x75, 0x32, 0x52, 0xe8, 0x10, 0xba, 0xbf, 0x66, 0x91, 0x31, 0xe6, 0x89, 0x68, 0xa2, 0x65,
+	0x51, 0x55, 0x19, 0x3a, 0x64, 0xd4, 0x63, 0x32, 0xca, 0xcf, 0x48, 0xd2, 0x55, 0x5e, 0xc0, 0xea,
+	0xe4, 0x37, 0x5b, 0xd3, 0xd5, 0xc9, 0x0f, 0x63, 0x31, 0x48, 0x4e, 0xee, 0x4f, 0x95, 0xc8, 0xc8,
+	0x8d, 0xa0, 0xdb, 0xfb, 0x0b, 0x7f, 0xbb, 0x72, 0x95, 0x0c, 0xa3, 0xd9, 0xd8, 0xbc, 0x04, 0x3c,
+	0x51, 0x7d, 0x5e, 0xbf, 0x00, 0xec, 0x98, 0x17, 0x80, 0xc1, 0xdb, 0x93, 0x41, 0x1a, 0xc2, 0x46,
+	0x97, 0xc6, 0xf5, 0xfe, 0x96, 0x45, 0x26, 0x0d, 0x33, 0x9e, 0xe1, 0x6c, 0xb0, 0x8e, 0xe7, 0x6c,
+	0x28, 0x3d, 0x61, 0x67, 0x83, 0xdb, 0x26, 0xc3, 0xb7, 0xfc, 0x60, 0xe7, 0x68, 0x8b, 0x21, 0xae,
+	0x87, 0xdd, 0xbe, 0xc5, 0x50, 0x43, 0x20, 0xf0, 0x32, 0xb9, 0x2d, 0x0d, 0xe5, 0x6f, 0x4b, 0xee,
+	0xfb, 0x16, 0x39, 0xbd, 0x4a, 0x3b, 0xa1, 0xff, 0x8e, 0x97, 0x46, 0xc8, 0x60, 0xa5, 0x96, 0x9f,
+	0x88, 0x60, 0x0a, 0x55, 0xe9, 0x3a, 0xde, 0x26, 0x6b, 0xf9, 0x0f, 0xb3, 0xb2, 0xb0, 0xd0, 0x45,
+	0x54, 0xf2, 0xd6, 0x52, 0x6d, 0x2b, 0x8d, 0x7d, 0x91, 0x05, 0x90, 0xe2, 0xb8, 0xff, 0xca, 0x22,
+	0xa3, 0xbc, 0x11, 0x54, 0xd2, 0xb6, 0x06, 0xd0, 0x6e, 0x91, 0x32, 0xab, 0x27, 0xbe, 0xcb, 0x4a,
+	0x01, 0xd6, 0x77, 0x24, 0xc7, 0x0f, 0xed, 0xec, 0x27, 0x70, 0x06, 0x4c, 0xf5, 0xf1, 0xee, 0x2d,
+	0xa8, 0xe0, 0xa0, 0x54, 0xf5, 0x61, 0x50, 0x10, 0xa5, 0xee, 0xaf, 0x0c, 0x91, 0x8a, 0xf4, 0x33,
+	0xf2, 0xab, 0x30, 0x41, 0x10, 0x26, 0x1e, 0x77, 0xc3, 0xf1, 0x95, 0xfc, 0xc6, 0xe3, 0xb7, 0x52,
+	0x72, 0x98, 0x5b, 0x48, 0xa9, 0x73, 0xeb, 0xba, 0x52, 0x64, 0xb5, 0x12, 0xd0, 0x1b, 0x61, 0x7f,
+	0x89, 0x8c, 0xb4, 0xbd, 0x2d, 0xda, 0x96, 0x0b, 0xfb, 0x4e, 0x81, 0xcd, 0xb9, 0xc5, 0x08, 0xf3,
+	0x96, 0xa8, 0x11, 0xe2, 0x40, 0x10, 0x5c, 0x67, 0x7e, 0x94, 0x4c, 0x67, 0x5b, 0x9d, 0x63, 0xca,
+	0x3f, 0x6b, 0x88, 0x76, 0xcd, 0xf2, 0x3e, 0xf3, 0x57, 0xc8, 0xb8, 0xc6, 0xe6, 0x38, 0x55, 0xdd,
+	0xd7, 0xc8, 0xf8, 0x2a, 0x4d, 0x22, 0xbf, 0xce, 0x08, 0x3c, 0x6c, 0x72, 0x1d, 0x69, 0x77, 0xf9,
+	0x2a, 0x9b, 0xac, 0x48, 0x33, 0x46, 0x87, 0x50, 0x37, 0x0a, 0x51, 0x07, 0xa6, 0x3d, 0xf9, 0xb1,
+	0x0b, 0x50, 0x6d, 0x37, 0x14, 0x4d, 0xee, 0x10, 0x4a, 0xff, 0x83, 0xc6, 0xcf, 0x7d, 0x89, 0x94,
+	0x57, 0x7b, 0x09, 0xbd, 0xf7, 0x70, 0x51, 0xe1, 0xbe, 0x41, 0x26, 0x18, 0xea, 0xf5, 0xb0, 0x8d,
+	0x32, 0x14, 0x7b, 0xda, 0xc1, 0xff, 0x59, 0x13, 0x1c, 0x43, 0x02, 0x5e, 0x86, 0x2b, 0xa0, 0x15,
+	0xb6, 0x1b, 0x2a, 0xfe, 0x58, 0x7d, 0xdf, 0xeb, 0x0c, 0x0a, 0xa2, 0xd4, 0xfd, 0x89, 0x12, 0x19,
+	0x67, 0x15, 0x85, 0xf4, 0xd8, 0x27, 0xa3, 0x2d, 0xce, 0x47, 0x0c, 0x49, 0x01, 0xf1, 0x24, 0x7a,
+	0xeb, 0x35, 0x85, 0x97, 0x03, 0x40, 0xf2, 0x43, 0xd6, 0x7b, 0x9e, 0x8f, 0x11, 0x14, 0x4e, 0xe9,
+	0x64, 0x59, 0xdf, 0xe5, 0x6c, 0x40, 0xf2, 0x73, 0xff, 0xa3, 0x45, 0x08, 0x06, 0xc5, 0x01, 0x8d,
+	0xf1, 0x16, 0xcc, 0x0f, 0x93, 0x72, 0xb7, 0xe5, 0xc5, 0x59, 0xb3, 0x7a, 0x79, 0x03, 0x81, 0x0f,
+	0xf0, 0x9a, 0x4d, 0xd8, 0xa0, 0xec, 0x0f, 0x70, 0x44, 0x3d, 0x1c, 0xb1, 0x74, 0x78, 0x38, 0xa2,
+	0xdd, 0x25, 0xa3, 0x61, 0x2f, 0x41, 0xcd, 0x41, 0xa8, 0x88, 0x05, 0x78, 0x95, 0xd6, 0x39, 0x41,
+	0x7e, 0x4d, 0x5e, 0xfc, 0x01, 0xc9, 0xc6, 0xfd, 0xd5, 0xd3, 0xbc, 0x77, 0xe2, 0x13, 0xcf, 0x90,
+	0x92, 0x2f, 0xcf, 0x84, 0x44, 0x34, 0xb3, 0x74, 0x63, 0x09, 0x4a, 0x7e, 0x43, 0xcd, 0xc6, 0xd2,
+	0xc0, 0x8d, 0xeb, 0x53, 0x64, 0xbc, 0xe1, 0xc7, 0xdd, 0xb6, 0xb7, 0xbf, 0x96, 0x73, 0x20, 0x5f,
+	0x4a, 0x8b, 0x40, 0xc7, 0xb3, 0x3f, 0x2e, 0x42, 0x48, 0xf9, 0x61, 0xdc, 0xc9, 0x84, 0x90, 0x56,
+	0xb0, 0x79, 0x5a, 0xf4, 0xe8, 0x2b, 0x64, 0x42, 0xee, 0xe8, 0x8c, 0x4b, 0x99, 0xd5, 0x52, 0xa1,
+	0x85, 0x9b, 0x5a, 0x19, 0x18, 0x98, 0x7d, 0xee, 0xfe, 0x91, 0x27, 0xef, 0xee, 0xff, 0x0c, 0x99,
+	0x94, 0x7f, 0xd9, 0x6e, 0xee, 0x9c, 0x65, 0xad, 0x57, 0x86, 0xa2, 0x4d, 0xbd, 0x10, 0x4c, 0xdc,
+	0x74, 0xea, 0x8d, 0x1e, 0x75, 0xea, 0x5d, 0x21, 0x64, 0x2b, 0xec, 0x05, 0x0d, 0x2f, 0xda, 0xbf,
+	0xb1, 0x24, 0x82, 0x75, 0x94, 0xc6, 0x58, 0x55, 0x25, 0xa0, 0x61, 0xe9, 0xd3, 0x75, 0xec, 0x21,
+	0xd3, 0xf5, 0x0d, 0x32, 0xc6, 0x02, 0x9b, 0x68, 0x63, 0x21, 0x71, 0xc8, 0xb1, 0x63, 0x60, 0x94,
+	0xf2, 0x50, 0x93, 0x44, 0x20, 0xa5, 0x67, 0x7f, 0x9e, 0x90, 0x6d, 0x3f, 0xf0, 0xe3, 0x16, 0xa3,
+	0x3e, 0x7e, 0x6c, 0xea, 0xaa, 0x9f, 0xcb, 0x8a, 0x0a, 0x68, 0x14, 0x31, 0xb4, 0x8c, 0xc6, 0x89,
+	0xdf, 0xf1, 0x12, 0xda, 0x50, 0xb7, 0x05, 0x1c, 0x66, 0x45, 0x50, 0xa1, 0x65, 0xd7, 0xb2, 0x08,
+	0x0f, 0xf2, 0x80, 0xd0, 0x4f, 0xc8, 0x7e, 0x85, 0x54, 0xba, 0x51, 0xd8, 0x8c, 0x68, 0x1c, 0x3b,
+	0x33, 0x6c, 0x18, 0x2f, 0x4a, 0xcd, 0x74, 0x43, 0xc0, 0x1f, 0x68, 0xbf, 0x41, 0x61, 0xdb, 0x7f,
+	0x6a, 0x91, 0xd3, 0x32, 0x59, 0x50, 0xac, 0x1a, 0x76, 0x8e, 0x49, 0xbd, 0x7a, 0x11, 0x49, 0x68,
+	0xe4, 0x62, 0x9f, 0x83, 0x2c, 0x17, 0xbe, 0xdd, 0x53, 0xd9, 0xfb, 0xbe, 0xf2, 0x07, 0x79, 0xc0,
+	0xf7, 0xbf, 0x33, 0x3b, 0xdb, 0x9f, 0x47, 0x49, 0x11, 0xc7, 0x95, 0xf7, 0xb7, 0xbe, 0x33, 0x3b,
+	0x2d, 0xff, 0xa7, 0x83, 0xd6, 0xd7, 0x49, 0xdc, 0xbd, 0xba, 0x61, 0xe3, 0xc6, 0x86, 0x33, 0x61,
+	0xee, 0x5e, 0x1b, 0x08, 0x04, 0x5e, 0x86, 0x0e, 0xa4, 0x86, 0x47, 0x3b, 0x61, 0x40, 0x1b, 0xce,
+	0x64, 0xea, 0x40, 0x5a, 0x12, 0x30, 0x50, 0xa5, 0xb8, 0xd8, 0xf8, 0xef, 0xc5, 0xc8, 0xc3, 0x8f,
+	0xee, 0x3c, 0x6b, 0x86, 0xe9, 0x2e, 0xe9, 0x85, 0x60, 0xe2, 0xda, 0x6d, 0x32, 0xe2, 0xb3, 0x33,
+	0x9c, 0x73, 0xea, 0xb2, 0x55, 0xcc, 0xc1, 0x91, 0x9f, 0x09, 0xf9, 0xa5, 0x15, 0xfe, 0x1b, 0x04,
+	0x0f, 0x5d, 0xf0, 0x4f, 0x3d, 0x11, 0xc1, 0x8f, 0xc3, 0x58, 0x6f, 0xf9, 0xed, 0x46, 0x44, 0x03,
+	0x67, 0x9a, 0x19, 0x9d, 0x27, 0x78, 0x36, 0x0f, 0x0e, 0x03, 0x55, 0x6a, 0xff, 0x08, 0x99, 0x0c,
+	0x7b, 0x09, 0x93, 0x10, 0x38, 0x79, 0x62, 0xe7, 0x34, 0x43, 0x67, 0x7e, 0xed, 0x75, 0xbd, 0x00,
+	0x4c, 0x3c, 0x94, 0xd4, 0xad, 0x30, 0x4e, 0xf0, 0x0f, 0x93, 0xd4, 0xe7, 0x4d, 0x49, 0x7d, 0x5d,
+	0x2b, 0x03, 0x03, 0x13, 0x65, 0x90, 0xdf, 0xf1, 0x9a, 0xf4, 0xc6, 0x92, 0xf3, 0x8c, 0x29, 0x83,
+	0x6e, 0x70, 0x30, 0xc8, 0x72, 0xf4, 0x25, 0xc9, 0x03, 0x67, 0x75, 0x3f, 0xa1, 0xf1, 0xed, 0x6e,
+	0x3b, 0xf4, 0x1a, 0xb4, 0xe1, 0x5c, 0x64, 0x4b, 0xb9, 0xef, 0x1a, 0xad, 0x81, 0x04, 0xf9, 0x75,
+	0x31, 0x7e, 0xf6, 0x74, 0x27, 0x7b, 0x7a, 0x72, 0x2e, 0xb0, 0x2f, 0x53, 0x2b, 0x42, 0xcb, 0xce,
+	0x90, 0xe6, 0xd6, 0xa9, 0x3e, 0x30, 0xf4, 0x37, 0x82, 0x5d, 0x48, 0x8e, 0xf7, 0x83, 0x7a, 0x2b,
+	0x0a, 0x03, 0xb3, 0x79, 0x4f, 0x5f, 0xb6, 0x8a, 0x39, 0x93, 0x30, 0x11, 0x91, 0xc7, 0xa2, 0xfa,
+	0x34, 0x8e, 0x64, 0x6e, 0x11, 0xe4, 0x37, 0x6a, 0x66, 0x89, 0x9c, 0xcf, 0x17, 0x33, 0x0f, 0x53,
+	0xf7, 0x87, 0x74, 0x75, 0x7f, 0x99, 0x3c, 0x3d, 0xb0, 0x51, 0x38, 0x59, 0xa4, 0x6e, 0x68, 0x99,
+	0x93, 0xa5, 0x4f, 0x97, 0x3b, 0x45, 0x26, 0xf4, 0x24, 0x5c, 0x2c, 0xc8, 0x41, 0xbb, 0x88, 0x8f,
+	0x16, 0x82, 0xb0, 0x56, 0x78, 0xb4, 0xc0, 0x7a, 0xad, 0x2f, 0x5a, 0x40, 0x81, 0x20, 0x65, 0x78,
+	0x94, 0x20, 0x87, 0xdc, 0xac, 0x01, 0x1f, 0x72, 0xb3, 0x8f, 0x1d, 0xe4, 0xf0, 0x1f, 0x86, 0x49,
+	0x4a, 0xe9, 0x98, 0xd7, 0x27, 0xd3, 0x90, 0x88, 0xd2, 0xa1, 0x21, 0x11, 0x0d, 0x32, 0xe5, 0xb1,
+	0xa8, 0xe8, 0x47, 0xbc, 0x34, 0xc9, 0x3c, 0x50, 0x0b, 0x26, 0x05, 0xc8, 0x92, 0x44, 0x2e, 0x71,
+	0x5a, 0x95, 0x71, 0x19, 0x3e, 0x36, 0x97, 0x9a, 0x49, 0x01, 0xb2, 0x24, 0xed, 0x37, 0x89, 0x53,
+	0x67, 0xf7, 0x50, 0x78, 0x1f, 0x6f, 0x6c, 0xaf, 0x85, 0xc9, 0x46, 0x44, 0x63, 0x1a, 0xf0, 0x80,
+	0x83, 0x4a, 0xf5, 0xb2, 0x18, 0x05, 0x67, 0x71, 0x00, 0x1e, 0x0c, 0xa4, 0x80, 0xbb, 0x24, 0x73,
+	0xa7, 0xfb, 0xc9, 0x3e, 0xb3, 0x82, 0x0b, 0x27, 0x93, 0xda, 0x25, 0x6b, 0x7a, 0x21, 0x98, 0xb8,
+	0xf6, 0x4f, 0x5b, 0x64, 0xb2, 0x2d, 0x4d, 0x72, 0xd0, 0x6b, 0x73, 0xdd, 0xb4, 0x10, 0xd3, 0xf6,
+	0x7a, 0xad, 0x76, 0x4b, 0xa7, 0xcc, 0x37, 0x1c, 0x03, 0x04, 0x26, 0x6f, 0xb4, 0xdc, 0x4f, 0x67,
+	0xab, 0xd9, 0x3b, 0xe4, 0xd9, 0x8e, 0x17, 0xed, 0xdc, 0x08, 0xb6, 0x23, 0x16, 0x11, 0x9a, 0xf0,
+	0xaf, 0xba, 0xb0, 0x9d, 0xd0, 0x68, 0xc9, 0xdb, 0xe7, 0x71, 0x5f, 0x65, 0x95, 0x99, 0xf0, 0xd9,
+	0xd5, 0xc3, 0x90, 0xe1, 0x70, 0x5a, 0xb8, 0x1b, 0x21, 0xc2, 0x12, 0x6d, 0x53, 0x94, 0x50, 0x29,
+	0x93, 0x12, 0x63, 0xa2, 0x76, 0xa3, 0xd5, 0x3c, 0x24, 0xc8, 0xaf, 0xeb, 0xfe, 0xfb, 0x12, 0x91,
+	0xfb, 0xf7, 0x5f, 0x6c, 0x83, 0xb2, 0xed, 0x92, 0x91, 0x88, 0x1d, 0xc3, 0xc5, 0xd9, 0x92, 0xa9,
+	0x52, 0xfc, 0x60, 0x0e, 0xa2, 0x04, 0x15, 0x1b, 0x7a, 0xcf, 0x4f, 0x16, 0x31, 0x39, 0x9b, 0xc8,
+	0xb3, 0xc7, 0x64, 0x89, 0x80, 0x81, 0x2a, 0x75, 0xff, 0xa6, 0x45, 0x26, 0xb1, 0x97, 0xed, 0x36,
+	0x6d, 0x63, 0x28, 0x61, 0x8c, 0x37, 0x78, 0x62, 0xfc, 0x51, 0x9c, 0x7d, 0x23, 0xbd, 0x8a, 0x40,
+	0xbb, 0x9a, 0x25, 0x17, 0x99, 0x00, 0xe7, 0xe5, 0xfe, 0xf7, 0x12, 0x19, 0x53, 0x83, 0x7d, 0x04,
+	0xf3, 0xf0, 0x95, 0x34, 0x65, 0x07, 0x97, 0x81, 0x8e, 0x96, 0xae, 0x03, 0x8f, 0x81, 0x0b, 0xc1,
+	0x3e, 0xbf, 0x1f, 0x9d, 0xe6, 0xee, 0xf8, 0xb8, 0xe9, 0x2c, 0x39, 0xaf, 0x5b, 0xe0, 0x35, 0x7c,
+	0x8e, 0x84, 0x7e, 0xbe, 0xd4, 0x57, 0x35, 0x5c, 0xd4, 0x7e, 0xa2, 0xbc, 0x52, 0x83, 0x9d, 0x54,
+	0x99, 0x1c, 0x83, 0xe5, 0x23, 0xe5, 0x18, 0x7c, 0x89, 0x0c, 0xd3, 0xa0, 0xd7, 0x61, 0x71, 0xf0,
+	0x63, 0x4c, 0x93, 0x1a, 0xbe, 0x16, 0xf4, 0x3a, 0x66, 0xcf, 0x18, 0x8a, 0xfb, 0x0d, 0x8b, 0x4c,
+	0xa9, 0xa1, 0xae, 0xb1, 0x84, 0xa7, 0xf6, 0x8f, 0x18, 0x17, 0x58, 0x9f, 0xcb, 0x58, 0x1f, 0xce,
+	0x64, 0xd0, 0x35, 0x43, 0x84, 0xe4, 0x5b, 0x7a, 0x28, 0x5f, 0x54, 0x51, 0xba, 0x5e, 0x92, 0xd0,
+	0x28, 0xc8, 0xde, 0x48, 0xdd, 0xe0, 0x60, 0x90, 0xe5, 0x38, 0x1b, 0xa6, 0xd3, 0xa5, 0x27, 0xda,
+	0xc8, 0x82, 0xe6, 0xde, 0xee, 0xf9, 0x11, 0x6d, 0xb0, 0xa9, 0x39, 0x26, 0x83, 0xe6, 0x38, 0x0c,
+	0x54, 0x29, 0x26, 0x5f, 0x40, 0x53, 0x62, 0x97, 0x46, 0x89, 0xbc, 0x6d, 0x3a, 0x7e, 0x65, 0xab,
+	0x40, 0x01, 0x21, 0x9a, 0x34, 0xb7, 0xa1, 0x98, 0xf0, 0x53, 0x63, 0x2a, 0x37, 0x54, 0x01, 0x68,
+	0x2d, 0x99, 0xf9, 0x39, 0x1c, 0x7a, 0xb3, 0x4e, 0x8e, 0x0a, 0xd8, 0x34, 0xe3, 0xbe, 0x5f, 0x2b,
+	0xb0, 0xe1, 0xbc, 0xdd, 0xba, 0x56, 0xf9, 0x2f, 0x2d, 0x82, 0x47, 0xcb, 0x95, 0x45, 0xfb, 0xaf,
+	0xf6, 0xa5, 0x28, 0xfc, 0x81, 0x9c, 0x14, 0x85, 0x93, 0x0c, 0xb9, 0x3f, 0x3b, 0xa1, 0xdd, 0x26,
+	0x93, 0xcc, 0x24, 0x2e, 0xf7, 0x75, 0xd1, 0xfa, 0xab, 0x47, 0xbc, 0x5b, 0xa8, 0x57, 0x15, 0xbb,
+	0x9c, 0x0e, 0x02, 0x93, 0xb8, 0xfb, 0x5b, 0xc3, 0x44, 0xb3, 0x1c, 0x1f, 0x41, 0x60, 0xbc, 0x9d,
+	0xf1, 0x13, 0xac, 0x16, 0xe2, 0x27, 0x90, 0xc6, 0x77, 0x2e, 0x84, 0x4d, 0xd7, 0x00, 0x36, 0xaa,
+	0x45, 0xdb, 0x5d, 0x67, 0xc8, 0x6c, 0xd4, 0x75, 0xda, 0xee, 0x02, 0x2b, 0x51, 0xb7, 0x32, 0x86,
+	0x07, 0xde, 0xca, 0x68, 0x91, 0x72, 0x13, 0xe3, 0x4a, 0x9d, 0x72, 0x51, 0x2e, 0x21, 0x16, 0xa6,
+	0xca, 0x5d, 0x42, 0xec, 0x27, 0x70, 0x06, 0x28, 0xef, 0x5a, 0xd2, 0xaf, 0xec, 0x8c, 0x14, 0x25,
+	0xef, 0x94, 0xab, 0x9a, 0xcb, 0x3b, 0xf5, 0x17, 0x52, 0x66, 0x78, 0xee, 0xaf, 0xf3, 0x2b, 0xc9,
+	0xce, 0x68, 0x51, 0xe7, 0x7e, 0x71, 0xc7, 0x99, 0x9f, 0xfb, 0xc5, 0x1f, 0x90, 0x6c, 0xdc, 0x79,
+	0x32, 0xae, 0x65, 0x1f, 0xc4, 0xcf, 0xa0, 0x6e, 0xc3, 0x6a, 0x9f, 0x01, 0x03, 0xe5, 0x81, 0x95,
+	0xb8, 0xff, 0x60, 0x88, 0x28, 0xe3, 0x8d, 0x7e, 0x49, 0xc2, 0xab, 0x6b, 0x69, 0x3e, 0x8c, 0xdb,
+	0x72, 0x61, 0x00, 0xa2, 0x14, 0x95, 0xcb, 0x0e, 0x8d, 0x9a, 0xea, 0xc4, 0xe5, 0x94, 0x4c, 0xe5,
+	0x72, 0x55, 0x2f, 0x04, 0x13, 0x17, 0x4f, 0x06, 0x1d, 0x2f, 0xf0, 0xb7, 0x69, 0x9c, 0x64, 0x63,
+	0xdd, 0x56, 0x05, 0x1c, 0x14, 0x06, 0xc6, 0x7f, 0xc6, 0x34, 0x59, 0xdf, 0xc3, 0xfb, 0xf7, 0xf2,
+	0x16, 0x9f, 0x33, 0x6c, 0xc6, 0x7f, 0xd6, 0xb2, 0x08, 0xd0, 0x5f, 0xc7, 0x5e, 0x22, 0xd3, 0xe2,
+	0x46, 0xa5, 0xba, 0x10, 0xe7, 0x94, 0x0d, 0xd3, 0xf4, 0x74, 0x2d, 0x53, 0x0e, 0x7d, 0x35, 0x90,
+	0x0a, 0x5e, 0xc8, 0xe8, 0x45, 0x34, 0xa5, 0x32, 0x62, 0x52, 0x59, 0xce, 0x94, 0x43, 0x5f, 0x0d,
+	0x16, 0x82, 0xdc, 0xf6, 0x9a, 0xb1, 0x33, 0xaa, 0x85, 0x20, 0x23, 0x00, 0x38, 0xdc, 0xfd, 0xe7,
+	0x16, 0x99, 0x04, 0x9a, 0x44, 0xfb, 0x0b, 0xdb, 0x68, 0xdb, 0x4c, 0xf6, 0xed, 0x5f, 0xb6, 0xc8,
+	0x74, 0x10, 0x36, 0xe8, 0x42, 0x90, 0xf8, 0x12, 0x58, 0x5c, 0xb2, 0x32, 0xc6, 0x6b, 0x2d, 0x43,
+	0x9e, 0x5f, 0xce, 0xcc, 0x42, 0xa1, 0xaf, 0x19, 0xee, 0x05, 0x72, 0x2e, 0x97, 0x80, 0xfb, 0xfb,
+	0x43, 0xa2, 0x1b, 0xea, 0xe3, 0xbf, 0x46, 0xca, 0x6d, 0x76, 0x51, 0xd5, 0x7a, 0xc4, 0xdc, 0x30,
+	0x6c, 0xac, 0xf8, 0x4d, 0x56, 0x4e, 0xc9, 0x5e, 0xc2, 0xcc, 0xbd, 0x49, 0x24, 0xaf, 0x11, 0xf3,
+	0xa9, 0xe8, 0xa6, 0x99, 0x7b, 0x55, 0xd1, 0x03, 0xf3, 0x2f, 0xe8, 0xd5, 0xec, 0x2f, 0x92, 0xd1,
+	0x2d, 0x9e, 0xee, 0xa6, 0x38, 0x1f, 0x8d, 0xc8, 0x9f, 0xc3, 0xf4, 0x32, 0x99, 0x4c, 0xe7, 0x41,
+	0xfa, 0x13, 0x24, 0x47, 0x7b, 0x9f, 0x54, 0x3c, 0xf9, 0x4d, 0x87, 0x8b, 0x0a, 0x5a, 0x35, 0xe6,
+	0x0f, 0xd7, 0x2c, 0xd4, 0x37, 0x54, 0xec, 0x50, 0x35, 0xa3, 0x69, 0xf2, 0xe2, 0x8c, 0x6a, 0xa6,
+	0x25, 0x2e, 0xd6, 0xb0, 0x30, 0x62, 0x87, 0xa4, 0x69, 0x26, 0x31, 0x09, 0x67, 0x7c, 0xd5, 0x30,
+	0x53, 0x14, 0x71, 0x0f, 0x50, 0x50, 0xd4, 0xee, 0xca, 0x08, 0x08, 0x28, 0x6e, 0x0f, 0x33, 0xad,
+	0xfc, 0xb1, 0x45, 0xce, 0xe6, 0xa5, 0xc3, 0xfc, 0x10, 0x5b, 0x7c, 0x5c, 0xab, 0x8a, 0xa8, 0xb0,
+	0x11, 0xd1, 0x6d, 0xff, 0x5e, 0x36, 0x3a, 0xe3, 0xa6, 0x2c, 0x80, 0x14, 0xc7, 0xfd, 0xf9, 0x32,
+	0x51, 0x8c, 0x4f, 0xc8, 0x0a, 0xf3, 0x02, 0x9e, 0xd7, 0x9a, 0x69, 0x1a, 0x26, 0x85, 0x07, 0x0c,
+	0x0a, 0xa2, 0x14, 0xf5, 0x5b, 0x19, 0xd4, 0x2f, 0x44, 0x36, 0x9b, 0x85, 0x32, 0xfe, 0x1f, 0x54,
+	0x69, 0x9e, 0x5d, 0xa7, 0xfc, 0x44, 0xec, 0x3a, 0x23, 0xc5, 0xdb, 0x75, 0x30, 0x41, 0x5e, 0xd8,
+	0xa6, 0x0b, 0xb0, 0xe6, 0x8c, 0x9a, 0xa7, 0x02, 0xe0, 0x60, 0x90, 0xe5, 0xd9, 0xdc, 0x5c, 0x95,
+	0xa3, 0xe5, 0xe6, 0xb2, 0x7f, 0xd3, 0x3a, 0xc4, 0x74, 0x34, 0x56, 0xd4, 0x9e, 0x90, 0x9b, 0x24,
+	0xa5, 0x7a, 0xf1, 0xd1, 0xec, 0x51, 0xee, 0xd7, 0x2c, 0x72, 0xaa, 0x56, 0x8f, 0xfc, 0x6e, 0x9a,
+	0xf4, 0xa6, 0xe8, 0x9c, 0x3c, 0x2f, 0xa8, 0x7b, 0x91, 0x99, 0xe9, 0x6b, 0xde, 0x64, 0x74, 0xdf,
+	0x22, 0xd3, 0x35, 0xda, 0xf1, 0xba, 0x2d, 0x76, 0xcd, 0x84, 0x47, 0x22, 0xcc, 0x93, 0xb1, 0x58,
+	0xc2, 0xb2, 0xa9, 0x48, 0x15, 0x32, 0xa4, 0x38, 0xf6, 0xf3, 0x3c, 0x6a, 0x42, 0x06, 0x08, 0x8f,
+	0x71, 0xbd, 0x8c, 0x87, 0x5a, 0xc4, 0x20, 0xcb, 0xdc, 0x3d, 0x32, 0x91, 0x56, 0xa7, 0xdb, 0x76,
+	0x93, 0x4c, 0xd5, 0xb5, 0x48, 0xf2, 0x34, 0x3a, 0xf3, 0xe8, 0x41, 0xe7, 0x6c, 0x16, 0x2e, 0x9a,
+	0x44, 0x20, 0x4b, 0xd5, 0xfd, 0xd9, 0x12, 0x99, 0x52, 0x9c, 0x85, 0x49, 0xfd, 0xbd, 0x6c, 0xa4,
+	0x07, 0x14, 0x71, 0x5f, 0xdb, 0x1c, 0xc9, 0x43, 0xa2, 0x3d, 0xde, 0xcb, 0x46, 0x7b, 0x9c, 0x28,
+	0xfb, 0x3e, 0x2f, 0xc1, 0xaf, 0x95, 0x48, 0x45, 0xdd, 0x1e, 0x7f, 0x8d, 0x94, 0x99, 0xea, 0xfc,
+	0x78, 0x7a, 0x08, 0x53, 0xc3, 0x81, 0x53, 0x42, 0x92, 0xcc, 0xcd, 0xed, 0x94, 0x1e, 0x87, 0x24,
+	0x73, 0x9a, 0x03, 0xa7, 0x64, 0xdf, 0x24, 0x43, 0x98, 0xc5, 0x64, 0xe8, 0x11, 0x09, 0xb2, 0x14,
+	0xc0, 0xd7, 0x82, 0x06, 0x20, 0x15, 0x96, 0x4f, 0x89, 0xef, 0x3b, 0xc3, 0xe6, 0xf2, 0x10, 0x9b,
+	0x8e, 0x28, 0x75, 0x7f, 0x7a, 0x88, 0x8c, 0xe0, 0xbd, 0x29, 0x3f, 0xb1, 0xff, 0x89, 0x45, 0xce,
+	0xec, 0x65, 0xd2, 0x87, 0xa5, 0x53, 0xf6, 0x76, 0xf1, 0xb9, 0xd9, 0x30, 0xd4, 0xe2, 0x19, 0xd1,
+	0xae, 0x33, 0x39, 0x85, 0x90, 0xd7, 0x1c, 0x23, 0xd5, 0xd2, 0xd0, 0x09, 0x25, 0xa5, 0x3b, 0xd9,
+	0x18, 0xd3, 0xc9, 0x81, 0xf1, 0xa5, 0x7f, 0x36, 0x4c, 0x08, 0xff, 0x1a, 0xeb, 0xdd, 0xe4, 0x28,
+	0x66, 0x81, 0x57, 0xc8, 0x84, 0x7c, 0xb7, 0x67, 0x2d, 0x8d, 0xeb, 0x51, 0xee, 0xd9, 0x15, 0xad,
+	0x0c, 0x0c, 0x4c, 0xa6, 0x0a, 0xa2, 0x01, 0x87, 0xab, 0x0b, 0xc3, 0x19, 0x55, 0x50, 0x95, 0x80,
+	0x86, 0x65, 0xcf, 0x19, 0x86, 0x6b, 0x9e, 0xe6, 0xe2, 0xd4, 0x21, 0x76, 0xe6, 0xcf, 0x90, 0x49,
+	0xf5, 0x6f, 0xd9, 0x6f, 0xd3, 0xac, 0x5b, 0x62, 0x43, 0x2f, 0x04, 0x13, 0x17, 0x33, 0x7d, 0x9a,
+	0xb7, 0x55, 0xc5, 0x06, 0xab, 0xee, 0x8a, 0x9b, 0x97, 0x5c, 0x21, 0x83, 0x8d, 0x2b, 0xa0, 0x11,
+	0xed, 0x43, 0x2f, 0x10, 0x3b, 0xad, 0x5a, 0x01, 0x4b, 0x0c, 0x0a, 0xa2, 0x14, 0x87, 0x10, 0x6b,
+	0xd2, 0x88, 0xc3, 0xc5, 0x75, 0x43, 0x35, 0x84, 0x35, 0xad, 0x0c, 0x0c, 0x4c, 0xe4, 0x20, 0x6c,
+	0x32, 0xc4, 0x5c, 0x63, 0x19, 0x43, 0x4a, 0x97, 0x9c, 0x0a, 0xcd, 0x23, 0x2d, 0x8f, 0x84, 0xf9,
+	0xe4, 0x11, 0xe7, 0xad, 0x51, 0x97, 0x5f, 0x8f, 0x31, 0x61, 0x90, 0xa1, 0x8f, 0xaa, 0x86, 0x1e,
+	0xe9, 0x3a, 0x61, 0x06, 0x71, 0x0d, 0x0a, 0x46, 0x75, 0xcf, 0x90, 0xd3, 0xb5, 0x5e, 0xb7, 0xdb,
+	0xf6, 0x69, 0x43, 0x59, 0x76, 0xdd, 0x1f, 0x23, 0x53, 0x22, 0x93, 0x92, 0xda, 0xcb, 0x8f, 0x95,
+	0x22, 0xd4, 0xfd, 0x53, 0x8b, 0x4c, 0x65, 0xbc, 0xbe, 0xe8, 0x81, 0x30, 0x77, 0xe0, 0x42, 0x0c,
+	0xf5, 0xfa, 0xe6, 0xcb, 0x57, 0x59, 0xee, 0x6e, 0xde, 0x92, 0x01, 0x96, 0x85, 0xc5, 0x29, 0xb3,
+	0x30, 0x44, 0x2e, 0xd2, 0xf5, 0x28, 0x4d, 0xf7, 0xab, 0x25, 0x92, 0xef, 0x6a, 0xb7, 0xbf, 0xd4,
+	0x3f, 0x00, 0xaf, 0x15, 0x38, 0x00, 0x9c, 0xcb, 0x21, 0x63, 0x10, 0x98, 0x63, 0xb0, 0x5a, 0xd0,
+	0x18, 0x08, 0xbe, 0xfd, 0x23, 0xf1, 0x27, 0x16, 0x19, 0xdf, 0xdc, 0xbc, 0xa5, 0x4c, 0x03, 0x40,
+	0xce, 0xc7, 0xfc, 0x2e, 0x17, 0xf3, 0x91, 0x2d, 0x86, 0x9d, 0x2e, 0x77, 0x99, 0x39, 0x56, 0x9a,
+	0xd4, 0xaa, 0x96, 0x8b, 0x01, 0x03, 0x6a, 0xda, 0x37, 0xc8, 0x19, 0xbd, 0x44, 0x18, 0x78, 0x84,
+	0xdb, 0x8e, 0xdf, 0x6e, 0xee, 0x2f, 0x86, 0xbc, 0x3a, 0x59, 0x52, 0xc2, 0xca, 0xe3, 0x0c, 0xe5,
+	0x93, 0x12, 0xc5, 0x90, 0x57, 0xc7, 0x5d, 0x27, 0xe3, 0xda, 0xfb, 0x64, 0xf6, 0x67, 0xc9, 0x74,
+	0x3d, 0xec, 0xc8, 0xd3, 0xf5, 0x2d, 0xba, 0x4b, 0xdb, 0xa2, 0xcb, 0xcc, 0x00, 0xb3, 0x98, 0x29,
+	0x83, 0x3e, 0x6c, 0xf7, 0x9b, 0x16, 0x19, 0x66, 0x89, 0x9c, 0x5e, 0x20, 0x23, 0x68, 0x9d, 0xb9,
+	0xd1, 0x77, 0x01, 0x10, 0x4d, 0x33, 0x37, 0x96, 0x40, 0x94, 0xe2, 0x01, 0xd8, 0x48, 0xe7, 0x54,
+	0xc8, 0x01, 0x58, 0x25, 0x18, 0x3d, 0xe4, 0xb6, 0x86, 0xfb, 0xfe, 0x25, 0xa2, 0xc0, 0x47, 0xd8,
+	0xcd, 0xba, 0x2a, 0x5e, 0xab, 0x5c, 0x70, 0xbc, 0x96, 0x1a, 0x9a, 0x4c, 0xcc, 0x56, 0x92, 0xc6,
+	0x6c, 0x8d, 0x14, 0x1d, 0xb3, 0xa5, 0x94, 0xd3, 0xbe, 0xb8, 0xad, 0x5f, 0xb0, 0xc8, 0x04, 0x7e,
+	0x1b, 0xe5, 0x6b, 0x18, 0x65, 0x1a, 0xf2, 0x9b, 0xc5, 0x7d, 0x95, 0xb9, 0x35, 0x8d, 0x3c, 0x77,
+	0xee, 0xa8, 0x1d, 0x4d, 0x2f, 0x02, 0xa3, 0x1d, 0xf6, 0xb2, 0x66, 0x9a, 0xe2, 0x49, 0x9e, 0x2e,
+	0xe6, 0x9d, 0x54, 0x1e, 0x6a, 0x67, 0xba, 0xa7, 0xe9, 0x68, 0x63, 0x45, 0xcd, 0x38, 0x79, 0xaf,
+	0x41, 0xb3, 0x20, 0x0b, 0x88, 0xa6, 0xbb, 0xb9, 0x64, 0x84, 0xc7, 0x00, 0x8a, 0x47, 0xbd, 0x98,
+	0x63, 0x83, 0x07, 0x09, 0x82, 0x28, 0xb1, 0x13, 0xe9, 0x21, 0x1e, 0x2f, 0x2a, 0xf3, 0xaa, 0xe1,
+	0x81, 0xce, 0x77, 0x11, 0xdb, 0xaf, 0xea, 0x07, 0xe0, 0x89, 0xa3, 0x1c, 0x80, 0x27, 0x07, 0x1e,
+	0x7e, 0xbf, 0x6e, 0x91, 0x89, 0xba, 0x96, 0x5a, 0xd6, 0x79, 0xb1, 0xa8, 0xfc, 0xc9, 0x79, 0x09,
+	0x6b, 0xf9, 0x2d, 0x41, 0xbd, 0x04, 0x0c, 0xee, 0x2c, 0x47, 0x11, 0x3b, 0xed, 0xb3, 0x60, 0xce,
+	0xf1, 0x2b, 0x1b, 0x05, 0xec, 0x64, 0x86, 0xf5, 0x80, 0x7f, 0x46, 0x0e, 0x03, 0xc1, 0xcb, 0x7e,
+	0x17, 0x1d, 0xaa, 0xc2, 0x06, 0x70, 0xaa, 0xa8, 0x88, 0x95, 0xac, 0x97, 0x44, 0x3a, 0x69, 0x39,
+	0x14, 0x14, 0x47, 0x7c, 0x7a, 0xa9, 0xe1, 0x35, 0x9d, 0xa9, 0xa2, 0xb6, 0x4f, 0x2d, 0x7d, 0x15,
+	0x3f, 0xca, 0x2d, 0x2d, 0xac, 0x00, 0xb2, 0xc0, 0xf7, 0xf7, 0x64, 0x86, 0xcb, 0xe9, 0xc2, 0x14,
+	0x05, 0x53, 0xa3, 0xe3, 0xf6, 0x8c, 0xbe, 0x84, 0x99, 0x0d, 0xe1, 0x58, 0xfa, 0xc1, 0xcb, 0x56,
+	0x31, 0xd9, 0xe9, 0xd0, 0x25, 0xc5, 0xaf, 0x27, 0xa7, 0xce, 0x29, 0xe4, 0xc2, 0xde, 0x3f, 0xfb,
+	0xa1, 0xa2, 0xb8, 0xe0, 0x15, 0xd9, 0xbe, 0x77, 0xcf, 0xae, 0x91, 0x51, 0x9e, 0xa3, 0x98, 0xc7,
+	0xbe, 0x8e, 0x5f, 0x99, 0x19, 0x9c, 0xe9, 0x38, 0x15, 0xdd, 0xfc, 0x7f, 0x0c, 0xb2, 0xae, 0xfd,
+	0xb3, 0x16, 0x39, 0x85, 0x32, 0x6e, 0x31, 0xcd, 0xdf, 0x6c, 0x17, 0x25, 0x45, 0x30, 0xbb, 0x41,
+	0xba, 0xfa, 0xd5, 0x39, 0xe7, 0x86, 0xc1, 0x0e, 0x32, 0xec, 0xed, 0xf7, 0x48, 0x25, 0xf6, 0x1b,
+	0xb4, 0xee, 0x45, 0xb1, 0x73, 0xe6, 0x64, 0x9a, 0x92, 0xda, 0xb8, 0x05, 0x23, 0x50, 0x2c, 0xed,
+	0xbf, 0xc3, 0x1e, 0x2e, 0x11, 0x0f, 0x5c, 0x89, 0xb7, 0x2e, 0xcf, 0x9e, 0xd8, 0x5b, 0x97, 0xdc,
+	0xf4, 0x6b, 0xb2, 0x83, 0x2c, 0x7f, 0xfb, 0x6f, 0xe0, 0x83, 0x3f, 0x2c, 0xd5, 0x67, 0x36, 0xcf,
+	0xeb, 0xb9, 0x47, 0x34, 0xae, 0xb0, 0xa0, 0xd9, 0x85, 0x3c, 0x92, 0x90, 0xcf, 0x89, 0xe5, 0x26,
+	0x8b, 0x74, 0x6f, 0x18, 0x0b, 0x9d, 0x2e, 0xce, 0xd7, 0x23, 0xc9, 0xf2, 0x60, 0x03, 0x03, 0x04,
+	0x26, 0x63, 0x7c, 0xa6, 0xac, 0x2b, 0x36, 0x28, 0x3f, 0xee, 0xb0, 0x10, 0xe8, 0x21, 0x7e, 0xc7,
+	0x65, 0x23, 0x05, 0x83, 0x8e, 0x63, 0x24, 0xaa, 0x7b, 0xe9, 0xb0, 0x44, 0x75, 0xf6, 0x6d, 0x32,
+	0x9e, 0x84, 0x6d, 0x1a, 0x89, 0xa3, 0xa6, 0xc3, 0x66, 0xe0, 0xa5, 0xbc, 0xb5, 0xb5, 0xa9, 0xd0,
+	0xd2, 0xa3, 0x68, 0x0a, 0x8b, 0x41, 0xa7, 0xc3, 0x22, 0x1a, 0x45, 0x0a, 0xd5, 0x88, 0x59, 0x36,
+	0x9e, 0xce, 0x44, 0x34, 0xea, 0x85, 0x60, 0xe2, 0xa2, 0x1b, 0xb9, 0x1b, 0xf9, 0x21, 0x86, 0x38,
+	0x2e, 0xb6, 0xbd, 0x38, 0x66, 0x04, 0xf8, 0x0d, 0x0e, 0xe5, 0x46, 0xde, 0xc8, 0x22, 0x40, 0x7f,
+	0x1d, 0x1c, 0x06, 0x09, 0x64, 0x41, 0xec, 0x65, 0x3e, 0x0c, 0xb2, 0x2e, 0xa8, 0xd2, 0x01, 0x69,
+	0xdb, 0x2e, 0x3e, 0x4a, 0xda, 0x36, 0xbb, 0x41, 0x2e, 0x7a, 0xbd, 0x24, 0x64, 0x57, 0xd4, 0xcd,
+	0x2a, 0x3c, 0xb8, 0xf3, 0x32, 0x8f, 0x17, 0x3d, 0xb8, 0x3f, 0x7b, 0x71, 0xe1, 0x10, 0x3c, 0x38,
+	0x94, 0x8a, 0xfd, 0x0e, 0xc6, 0xd8, 0xf1, 0xd4, 0x73, 0xce, 0x0f, 0x14, 0xb5, 0x6d, 0x9b, 0xc9,
+	0xec, 0x64, 0xd4, 0x1e, 0x87, 0x81, 0xe2, 0x67, 0x6f, 0x92, 0x71, 0xbc, 0x2b, 0xb0, 0xd0, 0xf6,
+	0xbd, 0x98, 0xc6, 0xce, 0xb3, 0x97, 0x87, 0x06, 0x69, 0x43, 0xd7, 0x25, 0x5a, 0x3a, 0x67, 0xae,
+	0xa7, 0x35, 0x41, 0x27, 0x63, 0x53, 0x32, 0x25, 0x23, 0x5b, 0x51, 0x76, 0xd1, 0x7b, 0x89, 0x73,
+	0x89, 0x75, 0xec, 0x85, 0x3c, 0xca, 0x1b, 0x61, 0xa3, 0x66, 0x62, 0x2b, 0x97, 0x8f, 0x0e, 0x84,
+	0x2c, 0x4d, 0x34, 0x18, 0x75, 0xc3, 0x06, 0x26, 0xc2, 0xde, 0xf0, 0x30, 0xb3, 0xd8, 0xac, 0x69,
+	0x73, 0xdb, 0xd0, 0xca, 0xc0, 0xc0, 0xc4, 0x48, 0x91, 0x0e, 0xbf, 0x9d, 0xea, 0x3c, 0x57, 0xd4,
+	0x69, 0x43, 0x5c, 0x77, 0xe5, 0x3b, 0xb8, 0xf8, 0x03, 0x92, 0x8d, 0xfd, 0xab, 0x16, 0x99, 0xca,
+	0x04, 0xf5, 0x3b, 0x1f, 0x2b, 0x4c, 0x89, 0x30, 0x09, 0x57, 0x5f, 0x60, 0xc3, 0x67, 0x02, 0x1f,
+	0xf4, 0x83, 0x20, 0xdb, 0x22, 0x3e, 0x2e, 0xec, 0x8a, 0xb9, 0xf3, 0x7c, 0x71, 0xe3, 0xc2, 0x08,
+	0xca, 0x71, 0x61, 0x7f, 0x40, 0xb2, 0x41, 0xb7, 0x9d, 0xc8, 0x28, 0xe3, 0xbc, 0x60, 0xba, 0xed,
+	0x44, 0xe2, 0x19, 0x90, 0xe5, 0x33, 0x3f, 0x46, 0x4e, 0xf7, 0x1d, 0xa6, 0x8e, 0x75, 0xcf, 0xf9,
+	0x17, 0xd1, 0xf4, 0xa1, 0x19, 0xb0, 0x8b, 0xce, 0xbf, 0xfc, 0x0a, 0x99, 0xa8, 0xf3, 0xc7, 0x3f,
+	0xf8, 0x75, 0xc4, 0x61, 0xd3, 0x80, 0xb9, 0xa8, 0x95, 0x81, 0x81, 0xe9, 0x5e, 0x27, 0x76, 0x7f,
+	0x32, 0xce, 0x4c, 0x90, 0x80, 0x75, 0xa4, 0x20, 0x81, 0x7f, 0x66, 0x91, 0x49, 0x43, 0x67, 0x28,
+	0xdc, 0xdf, 0xb7, 0x4c, 0xec, 0x8e, 0x1f, 0x45, 0x61, 0xa4, 0xbf, 0x3b, 0x21, 0xb2, 0x0f, 0xb2,
+	0xcc, 0x4c, 0xab, 0x7d, 0xa5, 0x90, 0x53, 0xc3, 0xfd, 0xed, 0x21, 0x92, 0x86, 0xad, 0xaa, 0x9c,
+	0x6c, 0xd6, 0xc0, 0x9c, 0x6c, 0x1f, 0x27, 0x15, 0x4c, 0x6a, 0xb1, 0x91, 0x66, 0x6e, 0x53, 0xdf,
+	0xe2, 0xd5, 0xda, 0xfa, 0x1a, 0xc3, 0x54, 0x18, 0x0c, 0xfb, 0xed, 0x65, 0xbf, 0x9d, 0xf4, 0xa7,
+	0xf6, 0x7a, 0xf5, 0x35, 0x0e, 0x07, 0x85, 0xc1, 0x5e, 0xc6, 0xd8, 0xa5, 0xca, 0xb2, 0x9d, 0xbe,
+	0x8c, 0xc1, 0xf3, 0xec, 0xb2, 0x32, 0x74, 0x56, 0x2a, 0xc3, 0xb8, 0xb0, 0xd3, 0xab, 0x91, 0x52,
+	0x06, 0x74, 0x48, 0x71, 0x98, 0x42, 0x28, 0xac, 0xb8, 0xce, 0x48, 0x51, 0xd7, 0x9d, 0xfa, 0xec,
+	0xc2, 0x5c, 0xb6, 0x4b, 0x30, 0x28, 0x96, 0x7a, 0x68, 0x73, 0xf9, 0xa8, 0xa1, 0xcd, 0xe6, 0x94,
+	0xab, 0x1c, 0x69, 0xca, 0xfd, 0xe4, 0x10, 0x19, 0xbd, 0x43, 0x23, 0xfc, 0x8d, 0xcb, 0x79, 0x97,
+	0xff, 0xcc, 0x5e, 0x1f, 0x12, 0x18, 0x20, 0xcb, 0x71, 0x38, 0xb7, 0x7a, 0x7e, 0xbb, 0xb1, 0x94,
+	0x2e, 0x2e, 0x35, 0x9c, 0x55, 0x59, 0x00, 0x29, 0x0e, 0x56, 0x68, 0xa2, 0xc2, 0xdd, 0xe9, 0xf8,
+	0x49, 0x36, 0x26, 0x63, 0x45, 0x16, 0x40, 0x8a, 0x83, 0x66, 0xb9, 0xa6, 0x9f, 0x6c, 0x7a, 0xcd,
+	0xac, 0xeb, 0x6d, 0x85, 0x41, 0x41, 0x94, 0x32, 0xdf, 0x8d, 0x9f, 0x6c, 0x46, 0x94, 0x59, 0x6b,
+	0xfb, 0x2e, 0x41, 0xaf, 0x68, 0x65, 0x60, 0x60, 0xb2, 0x26, 0x85, 0xa2, 0x67, 0xce, 0x48, 0xa6,
+	0x49, 0xb2, 0x00, 0x52, 0x1c, 0x9c, 0x96, 0x68, 0x46, 0xf4, 0xdb, 0x22, 0x46, 0x51, 0x7f, 0xfa,
+	0x5b, 0xc0, 0x41, 0x61, 0x20, 0x36, 0x4a, 0x16, 0x94, 0x0a, 0xd9, 0xc7, 0x01, 0x36, 0x04, 0x1c,
+	0x14, 0x86, 0x7b, 0x87, 0x4c, 0xf2, 0x05, 0xb6, 0xd8, 0xf6, 0xfc, 0xce, 0xca, 0xa2, 0x7d, 0xad,
+	0x2f, 0x10, 0xf7, 0xa5, 0x9c, 0x40, 0xdc, 0x73, 0x46, 0xa5, 0x9c, 0xe7, 0xc2, 0xbf, 0x5d, 0x22,
+	0x95, 0x27, 0xf8, 0xbe, 0x4a, 0xd7, 0x78, 0x5f, 0xa5, 0xe8, 0x57, 0x36, 0xf2, 0xde, 0x56, 0xb9,
+	0x97, 0x79, 0x5b, 0x65, 0xa3, 0x40, 0x9e, 0x87, 0xbf, 0xab, 0xf2, 0x7d, 0x8b, 0x9c, 0x95, 0xa8,
+	0x4c, 0xd6, 0x54, 0xfd, 0x80, 0x39, 0xed, 0x4f, 0x7e, 0x98, 0xdf, 0x35, 0x86, 0xf9, 0xf5, 0xe2,
+	0xba, 0xac, 0xf7, 0x63, 0xe0, 0xa3, 0x5f, 0xdf, 0xb3, 0x88, 0x93, 0x57, 0xe1, 0x09, 0x3c, 0x2c,
+	0xf3, 0x45, 0xf3, 0x61, 0x99, 0x3b, 0x27, 0xd3, 0xf3, 0x01, 0x0f, 0xcc, 0x7c, 0x7f, 0x40, 0xbf,
+	0x71, 0x68, 0xec, 0xb6, 0xdc, 0x85, 0xac, 0xa2, 0xdc, 0x61, 0x9c, 0x45, 0xfe, 0x76, 0xd6, 0x26,
+	0x23, 0x31, 0xf3, 0x70, 0x3b, 0xa5, 0xa2, 0x6c, 0xfc, 0xdc, 0x63, 0x2e, 0x6c, 0x84, 0xec, 0x37,
+	0x08, 0x1e, 0xee, 0x7f, 0xb2, 0xc8, 0xc4, 0x13, 0x7c, 0x3d, 0x28, 0x34, 0x3f, 0xf2, 0xab, 0xc5,
+	0x7d, 0xe4, 0x01, 0x1f, 0xf6, 0xff, 0x5e, 0x26, 0xc6, 0x43, 0x3d, 0xe8, 0x58, 0x95, 0x8a, 0xa1,
+	0xbc, 0x01, 0x55, 0xa4, 0xb3, 0x47, 0x6d, 0x33, 0x12, 0x12, 0x43, 0xca, 0x2f, 0x13, 0x53, 0x50,
+	0x3a, 0x52, 0x4c, 0xc1, 0x87, 0xfb, 0x7a, 0x48, 0xfe, 0xb1, 0x7d, 0xf8, 0x44, 0x8e, 0xed, 0x17,
+	0x0b, 0x3f, 0xb6, 0x3f, 0xfb, 0x84, 0x8f, 0xed, 0x9a, 0x0d, 0xb5, 0xfc, 0x18, 0x36, 0xd4, 0x2f,
+	0x92, 0xb3, 0xbb, 0xe9, 0xe6, 0xaf, 0x66, 0x92, 0x78, 0x04, 0xe5, 0xa5, 0xdc, 0xc3, 0x3a, 0x2a,
+	0x32, 0x71, 0x42, 0x83, 0x44, 0x53, 0x1b, 0x54, 0x3a, 0x8e, 0xb3, 0x77, 0x72, 0xc8, 0x41, 0x2e,
+	0x93, 0xac, 0x31, 0x6c, 0xf4, 0x08, 0xc6, 0xb0, 0x6f, 0x0e, 0x7c, 0x3f, 0xbc, 0x72, 0xb2, 0xef,
+	0x87, 0x3f, 0x7d, 0xec, 0xb7, 0xc3, 0x9f, 0x4f, 0x7d, 0x05, 0x3c, 0x8e, 0x25, 0xdf, 0xb0, 0xff,
+	0x2b, 0x59, 0x07, 0x24, 0x61, 0x43, 0xff, 0x85, 0x62, 0xb5, 0x9e, 0x02, 0x9c, 0x90, 0xe3, 0x8f,
+	0xe1, 0x84, 0xcc, 0x58, 0x26, 0x27, 0x0a, 0xb2, 0x4c, 0x06, 0x64, 0x9a, 0xe5, 0xad, 0xd8, 0xe8,
+	0xb5, 0xdb, 0x3c, 0x08, 0x58, 0xbe, 0xd0, 0x92, 0x1b, 0xd5, 0x89, 0x46, 0xe9, 0x76, 0xf6, 0x61,
+	0x2a, 0x75, 0x7d, 0xe4, 0x46, 0x86, 0x12, 0xf4, 0xd1, 0xc6, 0x09, 0xcb, 0xf2, 0x6a, 0xd0, 0x04,
+	0x47, 0x9b, 0x79, 0xba, 0x2a, 0xd5, 0x29, 0x69, 0x08, 0x13, 0x60, 0xd0, 0x71, 0xec, 0x9b, 0x64,
+	0xac, 0x11, 0xc4, 0xe2, 0x8a, 0xc4, 0x14, 0x13, 0x66, 0x9f, 0x40, 0x11, 0xb8, 0xb4, 0x56, 0x53,
+	0x97, 0x23, 0x2e, 0xe6, 0xe4, 0x7b, 0x51, 0xe5, 0x90, 0xd6, 0xb7, 0x57, 0x19, 0x31, 0x91, 0x64,
+	0x9b, 0x3b, 0xa0, 0x2e, 0x0f, 0xb0, 0xa7, 0x2d, 0xad, 0xc9, 0x34, 0xe1, 0x93, 0x82, 0x1d, 0xff,
+	0x0b, 0x29, 0x05, 0xed, 0xa5, 0x9c, 0xd3, 0x87, 0xbe, 0x94, 0xc3, 0x12, 0x3d, 0x25, 0x6d, 0x65,
+	0x3d, 0xbf, 0x54, 0x58, 0xa2, 0xa7, 0x34, 0x0a, 0x45, 0x24, 0x7a, 0x4a, 0x01, 0xa0, 0xb3, 0xb4,
+	0xd7, 0x07, 0x79, 0x11, 0xce, 0x30, 0xa1, 0x71, 0x7c, 0x9f, 0x80, 0x6e, 0x4e, 0x3e, 0x7b, 0xa8,
+	0x39, 0xb9, 0xcf, 0xfc, 0x7d, 0xee, 0x18, 0xe6, 0xef, 0x16, 0x4b, 0xc1, 0xb3, 0xb2, 0xe8, 0x9c,
+	0x2f, 0x4a, 0xa1, 0x63, 0x97, 0x26, 0x79, 0x54, 0x0f, 0xfb, 0x09, 0x9c, 0x81, 0xbd, 0x41, 0xce,
+	0x76, 0xc3, 0x46, 0x9f, 0x29, 0xdd, 0xb9, 0x60, 0x64, 0x4b, 0x3a, 0xbb, 0x91, 0x83, 0x03, 0xb9,
+	0x35, 0x99, 0x78, 0x4e, 0xe1, 0x2c, 0x97, 0x53, 0x59, 0x88, 0xe7, 0x14, 0x0c, 0x3a, 0x4e, 0xd6,
+	0x98, 0xfc, 0xf4, 0x89, 0x19, 0x93, 0x67, 0x9e, 0x80, 0x31, 0xf9, 0x99, 0x23, 0x1b, 0x93, 0xdf,
+	0x23, 0x67, 0xba, 0x61, 0x63, 0xc9, 0x8f, 0xa3, 0x1e, 0x8b, 0xd6, 0xaf, 0xf6, 0x1a, 0xf8, 0xe0,
+	0xd1, 0x2c, 0x6b, 0xe4, 0x15, 0xbd, 0x91, 0x5d, 0xb6, 0x90, 0xe7, 0x76, 0x5f, 0xde, 0xa2, 0x09,
+	0xff, 0x98, 0xd9, 0x5a, 0xec, 0xc0, 0xc4, 0xc2, 0x9a, 0x72, 0x0a, 0x21, 0x8f, 0x8f, 0x6e, 0xcb,
+	0xbe, 0xfc, 0x64, 0x6c, 0xd9, 0x9f, 0x25, 0x95, 0xb8, 0xd5, 0x4b, 0x1a, 0xe1, 0x5e, 0xc0, 0x1c,
+	0x16, 0x63, 0xea, 0xed, 0xca, 0x4a, 0x4d, 0xc0, 0x1f, 0xe0, 0xbd, 0x3e, 0xf1, 0x5b, 0x33, 0x29,
+	0x08, 0x08, 0x3e, 0xdb, 0x9f, 0x1b, 0xe1, 0xec, 0x9e, 0x64, 0x84, 0xf3, 0x85, 0x63, 0x45, 0x37,
+	0xe7, 0x19, 0xec, 0x9f, 0xfb, 0xc8, 0x19, 0xec, 0x7f, 0xd9, 0x22, 0x93, 0xbb, 0xba, 0xfd, 0xc6,
+	0xf9, 0x58, 0x51, 0xce, 0x4d, 0xc3, 0x2c, 0x54, 0x75, 0x51, 0xd8, 0x19, 0xa0, 0x07, 0x59, 0x00,
+	0x98, 0x2d, 0xc9, 0x71, 0xbc, 0x3e, 0xff, 0x61, 0x39, 0x5e, 0xdf, 0x63, 0xc2, 0x4c, 0x46, 0x29,
+	0x31, 0x4f, 0x43, 0xb1, 0x91, 0x50, 0x52, 0x30, 0x4a, 0x00, 0xe8, 0xfc, 0x30, 0x4a, 0x68, 0x5a,
+	0x1e, 0xce, 0x84, 0xfd, 0x35, 0x76, 0x7e, 0xb0, 0xa8, 0x46, 0xa8, 0x33, 0x21, 0x8b, 0x5b, 0xdc,
+	0xcc, 0xf0, 0x81, 0x3e, 0xce, 0xf8, 0xa6, 0xd8, 0x74, 0x37, 0x93, 0x82, 0xc0, 0x79, 0xb1, 0xa8,
+	0x50, 0x81, 0x6c, 0x72, 0x03, 0xde, 0xac, 0x2c, 0x14, 0xfa, 0x5a, 0x60, 0xbf, 0x4b, 0xce, 0x4a,
+	0x5d, 0xba, 0x96, 0x84, 0x91, 0xd7, 0xa4, 0xfc, 0x71, 0xd5, 0x97, 0x1e, 0x6e, 0x1d, 0x98, 0x93,
+	0xd1, 0x40, 0x73, 0xaf, 0xf5, 0xbc, 0x20, 0x41, 0x65, 0x14, 0x8d, 0xdd, 0x67, 0x17, 0x72, 0xe8,
+	0x41, 0x2e, 0x17, 0xcc, 0x52, 0x2b, 0xe1, 0x2b, 0x8b, 0x22, 0x04, 0xe6, 0x56, 0x71, 0xe7, 0x89,
+	0x95, 0x45, 0x1e, 0xa0, 0x9f, 0xfe, 0x07, 0x8d, 0xdf, 0xe3, 0xfb, 0xb6, 0xfe, 0xc0, 0x26, 0xa7,
+	0x32, 0x2f, 0xb5, 0x7e, 0xd2, 0x4c, 0xae, 0x7a, 0x29, 0x9b, 0xe1, 0x72, 0x52, 0xe2, 0x1b, 0x59,
+	0x2e, 0x8d, 0x34, 0x94, 0xa5, 0x13, 0x4d, 0x43, 0x39, 0xf4, 0x64, 0xd2, 0x50, 0x4e, 0x9f, 0x44,
+	0x1a, 0xca, 0xd3, 0xc7, 0x4a, 0x43, 0xa9, 0xa5, 0x01, 0x1d, 0x7e, 0x48, 0x1a, 0xd0, 0x05, 0x32,
+	0x25, 0x03, 0x8d, 0xa9, 0x48, 0x11, 0xc8, 0xfd, 0x11, 0x17, 0x44, 0x95, 0xa9, 0x45, 0xb3, 0x18,
+	0xb2, 0xf8, 0xf6, 0x07, 0x16, 0x29, 0x07, 0x61, 0x43, 0x1d, 0xe4, 0xdf, 0x28, 0xda, 0x9e, 0xcd,
+	0xce, 0x93, 0x22, 0x55, 0x89, 0x8c, 0x8e, 0x2a, 0x33, 0xd8, 0x03, 0xf9, 0x03, 0x78, 0x0b, 0x30,
+	0xdd, 0x56, 0xb8, 0xbd, 0xdd, 0x0e, 0xbd, 0x46, 0x9a, 0x2b, 0x53, 0x3a, 0x4c, 0xf8, 0x65, 0x0d,
+	0x95, 0x6e, 0x6b, 0x7d, 0x00, 0x1e, 0x0c, 0xa4, 0x80, 0x06, 0x81, 0xa9, 0x38, 0x09, 0x23, 0xda,
+	0x48, 0x8d, 0x17, 0x63, 0xac, 0xcf, 0xb4, 0xf0, 0x3e, 0xd7, 0x4c, 0x3e, 0xbc, 0xf7, 0xea, 0xa3,
+	0x64, 0x4a, 0x21, 0xdb, 0x2c, 0x3b, 0x22, 0xe7, 0xbb, 0x79, 0xb6, 0x93, 0xd8, 0x19, 0x7d, 0xa8,
+	0x05, 0x47, 0x2e, 0xdd, 0xf3, 0xb9, 0xd6, 0x97, 0x18, 0x06, 0x50, 0xd6, 0x13, 0x61, 0x56, 0x9e,
+	0x4c, 0x22, 0x4c, 0xf3, 0x7d, 0xe5, 0xc9, 0x27, 0xfe, 0xbe, 0xb2, 0xfd, 0x67, 0xb9, 0x09, 0x5f,
+	0xb9, 0xc9, 0xa1, 0x59, 0xf8, 0x9c, 0xf8, 0xc8, 0x25, 0x7d, 0xfd, 0xa7, 0x16, 0x99, 0xe1, 0x33,
+	0x2f, 0xab, 0xe8, 0xb2, 0x97, 0xeb, 0x4f, 0x9d, 0x88, 0x4f, 0x8d, 0x79, 0xfd, 0x6b, 0x06, 0x57,
+	0x84, 0xc3, 0x21, 0x2d, 0xc1, 0xc8, 0xfb, 0x3e, 0xf5, 0x7a, 0xaa, 0x28, 0x23, 0x5e, 0x7e, 0xbe,
+	0xcd, 0x33, 0x07, 0x47, 0xd1, 0xa8, 0xff, 0xc5, 0x40, 0x1b, 0xa3, 0xcd, 0x9a, 0xf7, 0xd7, 0x4f,
+	0xc8, 0xc6, 0xa8, 0x27, 0x05, 0x3d, 0x8e, 0xa5, 0x71, 0xe6, 0xa7, 0x44, 0x4a, 0xf5, 0x81, 0x69,
+	0xa0, 0xb6, 0xcc, 0x34, 0x50, 0xb7, 0x8a, 0x4c, 0x7b, 0xac, 0xbf, 0x40, 0xf0, 0xb7, 0x31, 0xef,
+	0x43, 0x8e, 0x90, 0xcc, 0x69, 0xd2, 0x17, 0xcc, 0x26, 0x15, 0xa8, 0x04, 0xeb, 0x0d, 0x2a, 0x26,
+	0x5d, 0xea, 0x4f, 0x8e, 0x69, 0x9e, 0x1d, 0x0c, 0xcb, 0xf9, 0xff, 0xcf, 0xb6, 0x17, 0x9c, 0xc7,
+	0xdd, 0x78, 0x80, 0xbd, 0xfc, 0x61, 0x3d, 0xc0, 0x3e, 0xf2, 0x28, 0x0f, 0xb0, 0x8f, 0x7e, 0x68,
+	0x0f, 0xb0, 0x57, 0x8e, 0xf8, 0x00, 0xfb, 0xd8, 0x47, 0xf4, 0x01, 0xf6, 0x7f, 0xac, 0x5e, 0x55,
+	0xe7, 0x9b, 0xf3, 0xe7, 0x8a, 0x4d, 0x0f, 0xf9, 0xe7, 0xef, 0x69, 0xf5, 0x3f, 0x2a, 0x91, 0x29,
+	0xb5, 0x95, 0x7a, 0xf1, 0x0e, 0xde, 0xf7, 0x39, 0xf9, 0x30, 0x91, 0x3d, 0x23, 0x4c, 0xa4, 0x48,
+	0xcb, 0x1c, 0xef, 0xc2, 0xc0, 0xa0, 0x9c, 0x2f, 0x67, 0x82, 0x72, 0xee, 0x16, 0xcf, 0xfa, 0xf0,
+	0xd8, 0x9c, 0xff, 0x61, 0x91, 0x33, 0x99, 0x1a, 0x4f, 0x20, 0x70, 0x61, 0xd7, 0x0c, 0x5c, 0x78,
+	0xad, 0xf0, 0x5e, 0x0f, 0x88, 0x5f, 0x78, 0xbf, 0xbf, 0xb7, 0x4c, 0x4f, 0xdb, 0x91, 0x0f, 0xf3,
+	0x5b, 0x45, 0xc9, 0xe5, 0xc1, 0xaf, 0xf2, 0xbb, 0xbf, 0x5e, 0x22, 0xe7, 0x72, 0x3f, 0x92, 0xfd,
+	0x55, 0x75, 0xa4, 0xb5, 0x8a, 0x4a, 0xc2, 0x99, 0xcb, 0x48, 0x3f, 0xd9, 0x4e, 0x1a, 0x27, 0x5b,
+	0x71, 0xa0, 0xfd, 0xb0, 0xd4, 0x2d, 0x91, 0x97, 0x57, 0x93, 0x07, 0xff, 0xd3, 0x22, 0xd3, 0x59,
+	0xd5, 0xfa, 0x09, 0x08, 0x84, 0x7b, 0x86, 0x40, 0xb8, 0x53, 0xbc, 0xa9, 0x7e, 0x60, 0xcc, 0xd8,
+	0x1f, 0x69, 0xc1, 0x72, 0x12, 0xf9, 0x09, 0xac, 0xc8, 0x3d, 0x73, 0x45, 0x42, 0xf1, 0x3d, 0x1e,
+	0xb0, 0x24, 0xdf, 0x26, 0x79, 0xde, 0x8a, 0xa3, 0xe5, 0x22, 0x31, 0xe2, 0xd0, 0x4b, 0x47, 0x8e,
+	0x43, 0xff, 0x99, 0x52, 0xff, 0x10, 0x33, 0x31, 0xf0, 0x35, 0x54, 0x7c, 0xb4, 0xb3, 0x5d, 0x71,
+	0xa9, 0x22, 0x8c, 0x93, 0xa4, 0x6a, 0xa3, 0x0e, 0x05, 0x83, 0xb3, 0xfd, 0x56, 0xda, 0x12, 0xfc,
+	0x52, 0x0f, 0xcd, 0xfb, 0x33, 0x68, 0x9a, 0x33, 0xb3, 0xf4, 0x5d, 0x8d, 0x12, 0xb3, 0xdb, 0x1b,
+	0xb4, 0xdd, 0x49, 0x32, 0xfe, 0xba, 0xdf, 0x55, 0x8e, 0x86, 0xb9, 0x6f, 0x7d, 0xf7, 0xd2, 0x53,
+	0xbf, 0xf3, 0xdd, 0x4b, 0x4f, 0x7d, 0xfb, 0xbb, 0x97, 0x9e, 0xfa, 0xca, 0xc1, 0x25, 0xeb, 0x5b,
+	0x07, 0x97, 0xac, 0xdf, 0x39, 0xb8, 0x64, 0x7d, 0xfb, 0xe0, 0x92, 0xf5, 0x9f, 0x0f, 0x2e, 0x59,
+	0x3f, 0xf7, 0x5f, 0x2e, 0x3d, 0xf5, 0x7a, 0x45, 0xf6, 0xed, 0xff, 0x0d, 0x00, 0xe8, 0xb6, 0x25,
+	0x3e, 0xd6, 0xaf, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -4183,6 +4449,23 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x6a
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.FromExpression)
 	copy(dAtA[i:], m.FromExpression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
@@ -4259,6 +4542,34 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4279,6 +4590,18 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4441,6 +4764,18 @@ func (m *ArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4728,6 +5063,135 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureArtifactRepository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifactRepository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.BlobNameFormat)
+	copy(dAtA[i:], m.BlobNameFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BlobNameFormat)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UseSDKCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4773,6 +5237,53 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BasicAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasicAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PasswordSecret != nil {
+		{
+			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UsernameSecret != nil {
+		{
+			size, err := m.UsernameSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Cache) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6259,6 +6770,18 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Headers) > 0 {
 		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6281,6 +6804,53 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BearerTokenSecret != nil {
+		{
+			size, err := m.BearerTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6997,6 +7567,28 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.DaemonCrashed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe8
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ArtifactBytesUploaded))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe0
+	i -= len(m.ImageID)
+	copy(dAtA[i:], m.ImageID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImageID)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -7619,6 +8211,109 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Enum) > 0 {
+		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enum[iNdEx])
+			copy(dAtA[i:], m.Enum[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Enum[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParametersSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParametersSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParametersSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Properties) > 0 {
+		keysForProperties := make([]string, 0, len(m.Properties))
+		for k := range m.Properties {
+			keysForProperties = append(keysForProperties, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+		for iNdEx := len(keysForProperties) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Properties[string(keysForProperties[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForProperties[iNdEx])
+			copy(dAtA[i:], keysForProperties[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProperties[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9786,6 +10481,48 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.ArtifactStorageLimit != nil {
+		{
+			size, err := m.ArtifactStorageLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ParametersSchema != nil {
+		{
+			size, err := m.ParametersSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.TemplateDefaults != nil {
 		{
 			size, err := m.TemplateDefaults.MarshalToSizedBuffer(dAtA[:i])
@@ -11071,6 +11808,23 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Checksum)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArtifactGC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11115,6 +11869,10 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11158,6 +11916,10 @@ func (m *ArtifactRepository) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11235,6 +11997,50 @@ func (m *ArtifactoryAuth) Size() (n int) {
 	return n
 }
 
+func (m *AzureArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blob)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureArtifactRepository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BlobNameFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureBlobContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11252,6 +12058,23 @@ func (m *Backoff) Size() (n int) {
 	return n
 }
 
+func (m *BasicAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Cache) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11801,6 +12624,27 @@ func (m *HTTPArtifact) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerTokenSecret != nil {
+		l = m.BearerTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12150,6 +12994,10 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.ImageID)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.ArtifactBytesUploaded))
+	n += 3
 	return n
 }
 
@@ -12313,6 +13161,49 @@ func (m *Parameter) Size() (n int) {
 	return n
 }
 
+func (m *ParameterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ParametersSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *PodGC) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13228,6 +14119,18 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.TemplateDefaults.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ParametersSchema != nil {
+		l = m.ParametersSchema.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactStorageLimit != nil {
+		l = m.ArtifactStorageLimit.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13553,6 +14456,18 @@ func (this *Artifact) String() string {
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13571,6 +14486,7 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13596,6 +14512,7 @@ func (this *ArtifactRepository) String() string {
 		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifactRepository", "HDFSArtifactRepository", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifactRepository", "OSSArtifactRepository", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifactRepository", "GCSArtifactRepository", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifactRepository", "AzureArtifactRepository", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13633,6 +14550,41 @@ func (this *ArtifactoryAuth) String() string {
 	}, "")
 	return s
 }
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifactRepository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifactRepository{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`BlobNameFormat:` + fmt.Sprintf("%v", this.BlobNameFormat) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -13645,6 +14597,17 @@ func (this *Backoff) String() string {
 	}, "")
 	return s
 }
+func (this *BasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BasicAuth{`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cache) String() string {
 	if this == nil {
 		return "nil"
@@ -13835,7 +14798,7 @@ func (this *DAGTask) String() string {
 	}
 	repeatedStringForWithItems += "}"
 	keysForHooks := make([]string, 0, len(this.Hooks))
-	for k := range this.Hooks {
+	for k, _ := range this.Hooks {
 		keysForHooks = append(keysForHooks, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
@@ -14069,6 +15032,18 @@ func (this *HTTPArtifact) String() string {
 	s := strings.Join([]string{`&HTTPArtifact{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Headers:` + repeatedStringForHeaders + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "HTTPAuth", "HTTPAuth", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPAuth{`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`BearerTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.BearerTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14195,7 +15170,7 @@ func (this *Metadata) String() string {
 		return "nil"
 	}
 	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
+	for k, _ := range this.Annotations {
 		keysForAnnotations = append(keysForAnnotations, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
@@ -14205,7 +15180,7 @@ func (this *Metadata) String() string {
 	}
 	mapStringForAnnotations += "}"
 	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
+	for k, _ := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
@@ -14306,7 +15281,7 @@ func (this *NodeStatus) String() string {
 		return "nil"
 	}
 	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
-	for k := range this.ResourcesDuration {
+	for k, _ := range this.ResourcesDuration {
 		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
@@ -14340,6 +15315,9 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`ImageID:` + fmt.Sprintf("%v", this.ImageID) + `,`,
+		`ArtifactBytesUploaded:` + fmt.Sprintf("%v", this.ArtifactBytesUploaded) + `,`,
+		`DaemonCrashed:` + fmt.Sprintf("%v", this.DaemonCrashed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14465,6 +15443,39 @@ func (this *Parameter) String() string {
 	}, "")
 	return s
 }
+func (this *ParameterSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterSchema{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParametersSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForProperties := make([]string, 0, len(this.Properties))
+	for k, _ := range this.Properties {
+		keysForProperties = append(keysForProperties, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+	mapStringForProperties := "map[string]ParameterSchema{"
+	for _, k := range keysForProperties {
+		mapStringForProperties += fmt.Sprintf("%v: %v,", k, this.Properties[k])
+	}
+	mapStringForProperties += "}"
+	s := strings.Join([]string{`&ParametersSchema{`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
+		`Properties:` + mapStringForProperties + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PodGC) String() string {
 	if this == nil {
 		return "nil"
@@ -14804,7 +15815,7 @@ func (this *Template) String() string {
 	}
 	repeatedStringForHostAliases += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
+	for k, _ := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
@@ -15034,7 +16045,7 @@ func (this *WorkflowSpec) String() string {
 	}
 	repeatedStringForHostAliases += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
+	for k, _ := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
@@ -15082,6 +16093,9 @@ func (this *WorkflowSpec) String() string {
 		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`PodMetadata:` + strings.Replace(this.PodMetadata.String(), "Metadata", "Metadata", 1) + `,`,
 		`TemplateDefaults:` + strings.Replace(this.TemplateDefaults.String(), "Template", "Template", 1) + `,`,
+		`ParametersSchema:` + strings.Replace(this.ParametersSchema.String(), "ParametersSchema", "ParametersSchema", 1) + `,`,
+		`ArtifactStorageLimit:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactStorageLimit), "Quantity", "resource.Quantity", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15101,7 +16115,7 @@ func (this *WorkflowStatus) String() string {
 	}
 	repeatedStringForConditions += "}"
 	keysForNodes := make([]string, 0, len(this.Nodes))
-	for k := range this.Nodes {
+	for k, _ := range this.Nodes {
 		keysForNodes = append(keysForNodes, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
@@ -15111,7 +16125,7 @@ func (this *WorkflowStatus) String() string {
 	}
 	mapStringForNodes += "}"
 	keysForStoredTemplates := make([]string, 0, len(this.StoredTemplates))
-	for k := range this.StoredTemplates {
+	for k, _ := range this.StoredTemplates {
 		keysForStoredTemplates = append(keysForStoredTemplates, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
@@ -15121,7 +16135,7 @@ func (this *WorkflowStatus) String() string {
 	}
 	mapStringForStoredTemplates += "}"
 	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
-	for k := range this.ResourcesDuration {
+	for k, _ := range this.ResourcesDuration {
 		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
@@ -15162,7 +16176,7 @@ func (this *WorkflowStep) String() string {
 	}
 	repeatedStringForWithItems += "}"
 	keysForHooks := make([]string, 0, len(this.Hooks))
-	for k := range this.Hooks {
+	for k, _ := range this.Hooks {
 		keysForHooks = append(keysForHooks, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
@@ -15235,7 +16249,7 @@ func (this *WorkflowTaskSetStatus) String() string {
 		return "nil"
 	}
 	keysForNodes := make([]string, 0, len(this.Nodes))
-	for k := range this.Nodes {
+	for k, _ := range this.Nodes {
 		keysForNodes = append(keysForNodes, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
@@ -16024,6 +17038,74 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			}
 			m.FromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16045,7 +17127,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16068,17 +17150,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16088,18 +17170,79 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16109,31 +17252,52 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16383,6 +17547,42 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16717,6 +17917,42 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifactRepository{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17375,7 +18611,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17398,15 +18634,48 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17434,11 +18703,61 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Blob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AzureArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17465,16 +18784,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
-			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobNameFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17502,7 +18818,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.BlobNameFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17525,7 +18841,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17548,15 +18864,79 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17583,13 +18963,33 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17611,7 +19011,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17634,17 +19034,17 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17654,28 +19054,27 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17702,10 +19101,45 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17727,7 +19161,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17750,15 +19184,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17785,13 +19219,16 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17818,8 +19255,10 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17844,7 +19283,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17867,17 +19306,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17887,29 +19326,83 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17919,29 +19412,30 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17951,23 +19445,24 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17990,7 +19485,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18013,15 +19508,15 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18048,15 +19543,15 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18066,23 +19561,25 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18105,7 +19602,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18128,17 +19625,17 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18148,31 +19645,29 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18182,24 +19677,287 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -21995,6 +23753,164 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &HTTPAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerTokenSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BearerTokenSecret == nil {
+				m.BearerTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.BearerTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25071,6 +26987,77 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactBytesUploaded", wireType)
+			}
+			m.ArtifactBytesUploaded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArtifactBytesUploaded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonCrashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DaemonCrashed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26208,12 +28195,340 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParameterSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ParameterSchemaType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParametersSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParametersSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParametersSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Required = append(m.Required, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26240,76 +28555,105 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.Properties == nil {
+				m.Properties = make(map[string]ParameterSchema)
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &ParameterSchema{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ParameterSchema{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			m.Properties[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34214,6 +36558,114 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametersSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParametersSchema == nil {
+				m.ParametersSchema = &ParametersSchema{}
+			}
+			if err := m.ParametersSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactStorageLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactStorageLimit == nil {
+				m.ArtifactStorageLimit = &resource.Quantity{}
+			}
+			if err := m.ArtifactStorageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
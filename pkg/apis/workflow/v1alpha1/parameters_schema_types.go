@@ -0,0 +1,34 @@
+package v1alpha1
+
+// ParameterSchemaType is the JSON type a parameter value must have
+type ParameterSchemaType string
+
+const (
+	ParameterSchemaTypeString  ParameterSchemaType = "string"
+	ParameterSchemaTypeInteger ParameterSchemaType = "integer"
+	ParameterSchemaTypeNumber  ParameterSchemaType = "number"
+	ParameterSchemaTypeBoolean ParameterSchemaType = "boolean"
+	ParameterSchemaTypeArray   ParameterSchemaType = "array"
+	ParameterSchemaTypeObject  ParameterSchemaType = "object"
+)
+
+// ParametersSchema is a subset of JSON Schema used to validate the parameters supplied to a workflow
+type ParametersSchema struct {
+	// Required is the list of parameters which must be supplied with a value
+	Required []string `json:"required,omitempty" protobuf:"bytes,1,rep,name=required"`
+
+	// Properties maps parameter names to the schema their values must satisfy
+	Properties map[string]ParameterSchema `json:"properties,omitempty" protobuf:"bytes,2,rep,name=properties"`
+}
+
+// ParameterSchema describes the constraints on the value of a single parameter
+type ParameterSchema struct {
+	// Type is the JSON type of the value. One of: string, integer, number, boolean, array, object
+	Type ParameterSchemaType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=ParameterSchemaType"`
+
+	// Enum is the list of values the parameter is allowed to take
+	Enum []AnyString `json:"enum,omitempty" protobuf:"bytes,2,rep,name=enum"`
+
+	// Pattern is a regular expression the value must match
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,3,opt,name=pattern"`
+}
@@ -87,6 +87,16 @@ const (
 	PodGCOnWorkflowSuccess    PodGCStrategy = "OnWorkflowSuccess"
 )
 
+// ArtifactGCStrategy is the strategy when to delete the stored objects of output artifacts.
+type ArtifactGCStrategy string
+
+// ArtifactGCStrategy
+const (
+	ArtifactGCNever                ArtifactGCStrategy = ""
+	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
+	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
+)
+
 // VolumeClaimGCStrategy is the strategy to use when deleting volumes from completed workflows
 type VolumeClaimGCStrategy string
 
@@ -355,6 +365,17 @@ type WorkflowSpec struct {
 
 	// TemplateDefaults holds default template values that will apply to all templates in the Workflow, unless overridden on the template-level
 	TemplateDefaults *Template `json:"templateDefaults,omitempty" protobuf:"bytes,39,opt,name=templateDefaults"`
+
+	// ParametersSchema is a JSON schema the workflow's arguments must satisfy before the workflow is run
+	ParametersSchema *ParametersSchema `json:"parametersSchema,omitempty" protobuf:"bytes,40,opt,name=parametersSchema"`
+
+	// ArtifactStorageLimit caps the total size of the output artifacts this workflow may upload, overriding the
+	// controller's default. Once exceeded, further uploads are refused and the workflow errors.
+	ArtifactStorageLimit *resource.Quantity `json:"artifactStorageLimit,omitempty" protobuf:"bytes,41,opt,name=artifactStorageLimit"`
+
+	// ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow from
+	// the artifact repository. It can be overridden by each artifact.
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,42,opt,name=artifactGC"`
 }
 
 // GetVolumeClaimGC returns the VolumeClaimGC that was defined in the workflow spec.  If none was provided, a default value is returned.
@@ -832,6 +853,39 @@ type Artifact struct {
 
 	// FromExpression, if defined, is evaluated to specify the value for the artifact
 	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,11,opt,name=fromExpression"`
+
+	// ArtifactGC describes the strategy to use when deleting this output artifact from the artifact
+	// repository, overriding the workflow's strategy
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,12,opt,name=artifactGC"`
+
+	// Checksum is the checksum of the artifact's stored file, as "sha256:" followed by the hex encoded digest. It
+	// is recorded when an output artifact is saved as a single file, and is verified when the artifact is loaded
+	// as an input. A directory saved with `archive: none` is stored as separate files, so it has no checksum.
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,13,opt,name=checksum"`
+}
+
+// ArtifactGC describes how to delete the stored objects of output artifacts
+type ArtifactGC struct {
+	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowDeletion". Defaults to never
+	// deleting artifacts.
+	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStrategy"`
+}
+
+// GetStrategy returns the strategy, or ArtifactGCNever if no strategy is set
+func (gc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
+	if gc == nil {
+		return ArtifactGCNever
+	}
+	return gc.Strategy
+}
+
+// GetArtifactGCStrategy returns the strategy used to delete an artifact, the artifact's own strategy takes precedence
+// over the given strategy of its workflow
+func (a *Artifact) GetArtifactGCStrategy(workflowArtifactGC *ArtifactGC) ArtifactGCStrategy {
+	if a.ArtifactGC != nil {
+		return a.ArtifactGC.Strategy
+	}
+	return workflowArtifactGC.GetStrategy()
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -936,6 +990,9 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// Azure contains Azure Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
 }
 
 func (a *ArtifactLocation) Get() ArtifactLocationType {
@@ -943,6 +1000,8 @@ func (a *ArtifactLocation) Get() ArtifactLocationType {
 		return nil
 	} else if a.Artifactory != nil {
 		return a.Artifactory
+	} else if a.Azure != nil {
+		return a.Azure
 	} else if a.Git != nil {
 		return a.Git
 	} else if a.GCS != nil {
@@ -967,6 +1026,8 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 	switch v := x.(type) {
 	case *ArtifactoryArtifact:
 		a.Artifactory = &ArtifactoryArtifact{}
+	case *AzureArtifact:
+		a.Azure = &AzureArtifact{}
 	case *GCSArtifact:
 		a.GCS = &GCSArtifact{}
 	case *HDFSArtifact:
@@ -1154,17 +1215,34 @@ type WorkflowStep struct {
 	// DEPRECATED: Use Hooks[exit].Template instead.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
 
-	// Hooks holds the lifecycle hook which is invoked at lifecycle of
-	// step, irrespective of the success, failure, or error status of the primary step
+	// Hooks holds the lifecycle hooks of the step. The exit hook is invoked irrespective of the success, failure, or
+	// error status of the primary step. The running, succeeded, failed and error hooks are each invoked once, when the
+	// step enters that phase
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
 }
 
 type LifecycleEvent string
 
 const (
-	ExitLifecycleEvent = "exit"
+	ExitLifecycleEvent      = "exit"
+	RunningLifecycleEvent   = "running"
+	SucceededLifecycleEvent = "succeeded"
+	FailedLifecycleEvent    = "failed"
+	ErrorLifecycleEvent     = "error"
 )
 
+var lifecycleEventPhases = map[LifecycleEvent]NodePhase{
+	RunningLifecycleEvent:   NodeRunning,
+	SucceededLifecycleEvent: NodeSucceeded,
+	FailedLifecycleEvent:    NodeFailed,
+	ErrorLifecycleEvent:     NodeError,
+}
+
+// Phase returns the node phase which triggers hooks for this event, or "" if the event is not triggered by a phase
+func (e LifecycleEvent) Phase() NodePhase {
+	return lifecycleEventPhases[e]
+}
+
 type LifecycleHooks map[LifecycleEvent]LifecycleHook
 
 func (lchs LifecycleHooks) GetExitHook() *LifecycleHook {
@@ -1709,6 +1787,9 @@ type NodeStatus struct {
 	// Daemoned tracks whether or not this node was daemoned and need to be terminated
 	Daemoned *bool `json:"daemoned,omitempty" protobuf:"varint,13,opt,name=daemoned"`
 
+	// DaemonCrashed is set when the pod of this node failed while the node was daemoned
+	DaemonCrashed bool `json:"daemonCrashed,omitempty" protobuf:"varint,29,opt,name=daemonCrashed"`
+
 	// Inputs captures input parameter values and artifact locations supplied to this template invocation
 	Inputs *Inputs `json:"inputs,omitempty" protobuf:"bytes,14,opt,name=inputs"`
 
@@ -1735,6 +1816,12 @@ type NodeStatus struct {
 	// HostNodeName name of the Kubernetes node on which the Pod is running, if applicable
 	HostNodeName string `json:"hostNodeName,omitempty" protobuf:"bytes,22,rep,name=hostNodeName"`
 
+	// ImageID is the resolved image digest of the main container, populated once the container has started
+	ImageID string `json:"imageID,omitempty" protobuf:"bytes,27,opt,name=imageID"`
+
+	// ArtifactBytesUploaded is the total size of the output artifacts uploaded by this node, as reported by the executor
+	ArtifactBytesUploaded int64 `json:"artifactBytesUploaded,omitempty" protobuf:"varint,28,opt,name=artifactBytesUploaded"`
+
 	// MemoizationStatus holds information about cached nodes
 	MemoizationStatus *MemoizationStatus `json:"memoizationStatus,omitempty" protobuf:"varint,23,opt,name=memoizationStatus"`
 
@@ -2129,6 +2216,27 @@ type HTTPArtifact struct {
 
 	// Headers are an optional list of headers to send with HTTP requests for artifacts
 	Headers []Header `json:"headers,omitempty" protobuf:"bytes,2,opt,name=headers"`
+
+	// Auth contains information for client authentication
+	Auth *HTTPAuth `json:"auth,omitempty" protobuf:"bytes,3,opt,name=auth"`
+}
+
+// HTTPAuth describes the credentials used to authenticate with an HTTP artifact server
+type HTTPAuth struct {
+	// BasicAuth is the username and password used for HTTP basic authentication
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,1,opt,name=basicAuth"`
+
+	// BearerTokenSecret is the secret selector to a token sent as a bearer token in the Authorization header
+	BearerTokenSecret *apiv1.SecretKeySelector `json:"bearerTokenSecret,omitempty" protobuf:"bytes,2,opt,name=bearerTokenSecret"`
+}
+
+// BasicAuth describes the secret selectors required for basic authentication
+type BasicAuth struct {
+	// UsernameSecret is the secret selector to the repository username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty" protobuf:"bytes,1,opt,name=usernameSecret"`
+
+	// PasswordSecret is the secret selector to the repository password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,2,opt,name=passwordSecret"`
 }
 
 func (h *HTTPArtifact) GetKey() (string, error) {
@@ -2153,6 +2261,42 @@ func (h *HTTPArtifact) HasLocation() bool {
 	return h != nil && h.URL != ""
 }
 
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Endpoint is the service url associated with an account. It is most likely "https://<ACCOUNT_NAME>.blob.core.windows.net"
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Container is the container where resources will be stored
+	Container string `json:"container" protobuf:"bytes,2,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the Azure Blob Storage account access key
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,3,opt,name=accountKeySecret"`
+
+	// UseSDKCreds tells the driver to authenticate using the pod's managed identity instead of an account key
+	UseSDKCreds bool `json:"useSDKCreds,omitempty" protobuf:"varint,4,opt,name=useSDKCreds"`
+}
+
+// AzureArtifact is the location of an Azure Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the blob name (i.e., path) in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) GetKey() (string, error) {
+	return a.Blob, nil
+}
+
+func (a *AzureArtifact) SetKey(key string) error {
+	a.Blob = key
+	return nil
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
+}
+
 // GCSBucket contains the access information for interfacring with a GCS bucket
 type GCSBucket struct {
 	// Bucket is the name of the bucket
@@ -2441,8 +2585,9 @@ type DAGTask struct {
 	// Depends are name of other targets which this depends on
 	Depends string `json:"depends,omitempty" protobuf:"bytes,12,opt,name=depends"`
 
-	// Hooks hold the lifecycle hook which is invoked at lifecycle of
-	// task, irrespective of the success, failure, or error status of the primary task
+	// Hooks hold the lifecycle hooks of the task. The exit hook is invoked irrespective of the success, failure, or
+	// error status of the primary task. The running, succeeded, failed and error hooks are each invoked once, when the
+	// task enters that phase
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
 }
 
@@ -2569,6 +2714,26 @@ func (wf *Workflow) GetTemplateByName(name string) *Template {
 	return nil
 }
 
+// HasArtifactGCOnCompletion returns whether any output artifacts of the workflow's nodes are deleted when the workflow
+// completes, in which case they are no longer available once it has completed
+func (wf *Workflow) HasArtifactGCOnCompletion() bool {
+	artifactGC := wf.Spec.ArtifactGC
+	if wf.Status.StoredWorkflowSpec != nil {
+		artifactGC = wf.Status.StoredWorkflowSpec.ArtifactGC
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if art.HasLocation() && art.GetArtifactGCStrategy(artifactGC) == ArtifactGCOnWorkflowCompletion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (wf *Workflow) GetNodeByName(nodeName string) *NodeStatus {
 	nodeID := wf.NodeID(nodeName)
 	node, ok := wf.Status.Nodes[nodeID]
@@ -102,6 +102,15 @@ func TestArtifactoryArtifact(t *testing.T) {
 	assert.Equal(t, "/my-key", key, "has leading slash")
 }
 
+func TestAzureArtifact(t *testing.T) {
+	a := &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "https://my-account.blob.core.windows.net", Container: "my-container"}}
+	assert.True(t, a.HasLocation())
+	assert.NoError(t, a.SetKey("my-blob"))
+	key, err := a.GetKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "my-blob", key)
+}
+
 func TestGitArtifact(t *testing.T) {
 	a := &GitArtifact{Repo: "my-repo"}
 	assert.True(t, a.HasLocation())
@@ -194,6 +203,7 @@ func TestArtifactLocation_Get(t *testing.T) {
 	var l *ArtifactLocation
 	assert.Nil(t, l.Get())
 	assert.Nil(t, (&ArtifactLocation{}).Get())
+	assert.IsType(t, &AzureArtifact{}, (&ArtifactLocation{Azure: &AzureArtifact{}}).Get())
 	assert.IsType(t, &GitArtifact{}, (&ArtifactLocation{Git: &GitArtifact{}}).Get())
 	assert.IsType(t, &GCSArtifact{}, (&ArtifactLocation{GCS: &GCSArtifact{}}).Get())
 	assert.IsType(t, &HDFSArtifact{}, (&ArtifactLocation{HDFS: &HDFSArtifact{}}).Get())
@@ -213,6 +223,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		assert.NoError(t, l.SetType(&ArtifactoryArtifact{}))
 		assert.NotNil(t, l.Artifactory)
 	})
+	t.Run("Azure", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		assert.NoError(t, l.SetType(&AzureArtifact{}))
+		assert.NotNil(t, l.Azure)
+	})
 	t.Run("GCS", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		assert.NoError(t, l.SetType(&GCSArtifact{}))
@@ -277,6 +292,12 @@ func TestArtifactLocation_Key(t *testing.T) {
 		err = l.SetKey("my-file")
 		assert.Error(t, err, "cannot set Git key")
 	})
+	t.Run("Azure", func(t *testing.T) {
+		l := &ArtifactLocation{Azure: &AzureArtifact{Blob: "my-dir"}}
+		err := l.AppendToKey("my-file")
+		assert.NoError(t, err)
+		assert.Equal(t, "my-dir/my-file", l.Azure.Blob, "appends to Azure blob name")
+	})
 	t.Run("GCS", func(t *testing.T) {
 		l := &ArtifactLocation{GCS: &GCSArtifact{Key: "my-dir"}}
 		err := l.AppendToKey("my-file")
@@ -103,6 +103,11 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArchiveStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
 	return
 }
 
@@ -116,6 +121,23 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
+	*out = *in
+
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactGC.
+func (in *ArtifactGC) DeepCopy() *ArtifactGC {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactGC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -164,6 +186,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(GCSArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -227,6 +254,11 @@ func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 		*out = new(GCSArtifactRepository)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -360,6 +392,61 @@ func (in Artifacts) DeepCopy() Artifacts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifact) DeepCopyInto(out *AzureArtifact) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifact.
+func (in *AzureArtifact) DeepCopy() *AzureArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifactRepository) DeepCopyInto(out *AzureArtifactRepository) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifactRepository.
+func (in *AzureArtifactRepository) DeepCopy() *AzureArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobContainer) DeepCopyInto(out *AzureBlobContainer) {
+	*out = *in
+	if in.AccountKeySecret != nil {
+		in, out := &in.AccountKeySecret, &out.AccountKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainer.
+func (in *AzureBlobContainer) DeepCopy() *AzureBlobContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -381,6 +468,32 @@ func (in *Backoff) DeepCopy() *Backoff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
+	*out = *in
+	if in.UsernameSecret != nil {
+		in, out := &in.UsernameSecret, &out.UsernameSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuth.
+func (in *BasicAuth) DeepCopy() *BasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cache) DeepCopyInto(out *Cache) {
 	*out = *in
@@ -1147,6 +1260,11 @@ func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
 		*out = make([]Header, len(*in))
 		copy(*out, *in)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1160,6 +1278,32 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAuth) DeepCopyInto(out *HTTPAuth) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAuth.
+func (in *HTTPAuth) DeepCopy() *HTTPAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
 	*out = *in
@@ -1822,6 +1966,55 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSchema) DeepCopyInto(out *ParameterSchema) {
+	*out = *in
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]AnyString, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSchema.
+func (in *ParameterSchema) DeepCopy() *ParameterSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersSchema) DeepCopyInto(out *ParametersSchema) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]ParameterSchema, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersSchema.
+func (in *ParametersSchema) DeepCopy() *ParametersSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGC) DeepCopyInto(out *PodGC) {
 	*out = *in
@@ -3023,6 +3216,21 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(Template)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ParametersSchema != nil {
+		in, out := &in.ParametersSchema, &out.ParametersSchema
+		*out = new(ParametersSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactStorageLimit != nil {
+		in, out := &in.ArtifactStorageLimit, &out.ArtifactStorageLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
 	return
 }
 
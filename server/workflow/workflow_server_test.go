@@ -894,6 +894,26 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 			assert.Contains(t, wf.Annotations, "annotationTest")
 		}
 	})
+	t.Run("SubmitFromWorkflowTemplateWithParameters", func(t *testing.T) {
+		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"message=hello"}},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "hello", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+		}
+	})
+	t.Run("SubmitFromWorkflowTemplateWithUndeclaredParameters", func(t *testing.T) {
+		_, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"massage=hello"}},
+		})
+		assert.EqualError(t, err, `parameter "massage" is not declared in spec.arguments.parameters`)
+	})
 	t.Run("SubmitFromCronWorkflow", func(t *testing.T) {
 		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
 			Namespace:    "workflows",
@@ -550,6 +550,11 @@ export interface NodeStatus {
      */
     hostNodeName: string;
 
+    /**
+     * ImageID is the resolved image digest of the main container, populated once the container has started.
+     */
+    imageID?: string;
+
     /**
      * Memoization
      */
@@ -3,9 +3,12 @@ package executor
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sync"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/artifactory"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/gcs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/git"
@@ -17,134 +20,238 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/s3"
 )
 
-var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
+var (
+	ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
+	ErrDeleteUnsupported = fmt.Errorf("artifact driver does not support deletion")
+)
 
 type NewDriverFunc func(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error)
 
-// NewDriver initializes an instance of an artifact driver
+var (
+	driversLock sync.RWMutex
+	// drivers maps the type of an artifact location (e.g. *wfv1.S3Artifact) to the function that creates its driver
+	drivers = map[reflect.Type]NewDriverFunc{}
+)
+
+func init() {
+	drivers[reflect.TypeOf(&wfv1.ArtifactoryArtifact{})] = newArtifactoryDriver
+	drivers[reflect.TypeOf(&wfv1.AzureArtifact{})] = newAzureDriver
+	drivers[reflect.TypeOf(&wfv1.GCSArtifact{})] = newGCSDriver
+	drivers[reflect.TypeOf(&wfv1.GitArtifact{})] = newGitDriver
+	drivers[reflect.TypeOf(&wfv1.HDFSArtifact{})] = newHDFSDriver
+	drivers[reflect.TypeOf(&wfv1.HTTPArtifact{})] = newHTTPDriver
+	drivers[reflect.TypeOf(&wfv1.OSSArtifact{})] = newOSSDriver
+	drivers[reflect.TypeOf(&wfv1.RawArtifact{})] = newRawDriver
+	drivers[reflect.TypeOf(&wfv1.S3Artifact{})] = newS3Driver
+}
+
+// RegisterDriver replaces the function used to create the driver for artifacts located at the given type of
+// location, e.g. to use a different implementation of S3 in a custom executor build. Only the built-in location
+// types of wfv1.ArtifactLocation can be used, as an artifact cannot specify any other location; RegisterDriver
+// panics for any other type.
+func RegisterDriver(locationType wfv1.ArtifactLocationType, newDriver NewDriverFunc) {
+	driversLock.Lock()
+	defer driversLock.Unlock()
+	t := reflect.TypeOf(locationType)
+	if _, ok := drivers[t]; !ok {
+		panic(fmt.Sprintf("%v is not an artifact location type", t))
+	}
+	drivers[t] = newDriver
+}
+
+// NewDriver initializes an instance of an artifact driver. If the artifact has more than one location set, the
+// location returned by wfv1.ArtifactLocation.Get is used.
 func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
-	if art.S3 != nil {
-		var accessKey string
-		var secretKey string
+	location := art.Get()
+	if location == nil {
+		return nil, ErrUnsupportedDriver
+	}
+	driversLock.RLock()
+	newDriver, ok := drivers[reflect.TypeOf(location)]
+	driversLock.RUnlock()
+	if !ok {
+		return nil, ErrUnsupportedDriver
+	}
+	return newDriver(ctx, art, ri)
+}
 
-		if art.S3.AccessKeySecret != nil && art.S3.AccessKeySecret.Name != "" {
-			accessKeyBytes, err := ri.GetSecret(ctx, art.S3.AccessKeySecret.Name, art.S3.AccessKeySecret.Key)
-			if err != nil {
-				return nil, err
-			}
-			accessKey = accessKeyBytes
-			secretKeyBytes, err := ri.GetSecret(ctx, art.S3.SecretKeySecret.Name, art.S3.SecretKeySecret.Key)
-			if err != nil {
-				return nil, err
-			}
-			secretKey = secretKeyBytes
-		}
+// DeleteArtifact deletes an artifact from its location, returning ErrDeleteUnsupported if its driver
+// cannot delete artifacts
+func DeleteArtifact(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) error {
+	driver, err := NewDriver(ctx, art, ri)
+	if err != nil {
+		return err
+	}
+	deleter, ok := driver.(common.ArtifactDeleter)
+	if !ok {
+		return ErrDeleteUnsupported
+	}
+	return deleter.Delete(art)
+}
+
+func newS3Driver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	var accessKey string
+	var secretKey string
 
-		driver := s3.ArtifactDriver{
-			Endpoint:    art.S3.Endpoint,
-			AccessKey:   accessKey,
-			SecretKey:   secretKey,
-			Secure:      art.S3.Insecure == nil || !*art.S3.Insecure,
-			Region:      art.S3.Region,
-			RoleARN:     art.S3.RoleARN,
-			UseSDKCreds: art.S3.UseSDKCreds,
+	if art.S3.AccessKeySecret != nil && art.S3.AccessKeySecret.Name != "" {
+		accessKeyBytes, err := ri.GetSecret(ctx, art.S3.AccessKeySecret.Name, art.S3.AccessKeySecret.Key)
+		if err != nil {
+			return nil, err
 		}
-		return &driver, nil
+		accessKey = accessKeyBytes
+		secretKeyBytes, err := ri.GetSecret(ctx, art.S3.SecretKeySecret.Name, art.S3.SecretKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		secretKey = secretKeyBytes
 	}
-	if art.HTTP != nil {
-		return &http.ArtifactDriver{}, nil
+
+	driver := s3.ArtifactDriver{
+		Endpoint:    art.S3.Endpoint,
+		AccessKey:   accessKey,
+		SecretKey:   secretKey,
+		Secure:      art.S3.Insecure == nil || !*art.S3.Insecure,
+		Region:      art.S3.Region,
+		RoleARN:     art.S3.RoleARN,
+		UseSDKCreds: art.S3.UseSDKCreds,
 	}
-	if art.Git != nil {
-		gitDriver := git.ArtifactDriver{
-			InsecureIgnoreHostKey: art.Git.InsecureIgnoreHostKey,
-			DisableSubmodules:     art.Git.DisableSubmodules,
-		}
-		if art.Git.UsernameSecret != nil {
-			usernameBytes, err := ri.GetSecret(ctx, art.Git.UsernameSecret.Name, art.Git.UsernameSecret.Key)
-			if err != nil {
-				return nil, err
+	return &driver, nil
+}
+
+func newHTTPDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	driver := http.ArtifactDriver{}
+	if auth := art.HTTP.Auth; auth != nil {
+		if auth.BasicAuth != nil {
+			if s := auth.BasicAuth.UsernameSecret; s != nil && s.Name != "" {
+				username, err := ri.GetSecret(ctx, s.Name, s.Key)
+				if err != nil {
+					return nil, err
+				}
+				driver.Username = username
 			}
-			gitDriver.Username = usernameBytes
-		}
-		if art.Git.PasswordSecret != nil {
-			passwordBytes, err := ri.GetSecret(ctx, art.Git.PasswordSecret.Name, art.Git.PasswordSecret.Key)
-			if err != nil {
-				return nil, err
+			if s := auth.BasicAuth.PasswordSecret; s != nil && s.Name != "" {
+				password, err := ri.GetSecret(ctx, s.Name, s.Key)
+				if err != nil {
+					return nil, err
+				}
+				driver.Password = password
 			}
-			gitDriver.Password = passwordBytes
 		}
-		if art.Git.SSHPrivateKeySecret != nil {
-			sshPrivateKeyBytes, err := ri.GetSecret(ctx, art.Git.SSHPrivateKeySecret.Name, art.Git.SSHPrivateKeySecret.Key)
+		if s := auth.BearerTokenSecret; s != nil && s.Name != "" {
+			token, err := ri.GetSecret(ctx, s.Name, s.Key)
 			if err != nil {
 				return nil, err
 			}
-			gitDriver.SSHPrivateKey = sshPrivateKeyBytes
+			driver.BearerToken = token
 		}
+	}
+	return &driver, nil
+}
 
-		return &gitDriver, nil
+func newGitDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	gitDriver := git.ArtifactDriver{
+		InsecureIgnoreHostKey: art.Git.InsecureIgnoreHostKey,
+		DisableSubmodules:     art.Git.DisableSubmodules,
 	}
-	if art.Artifactory != nil {
-		usernameBytes, err := ri.GetSecret(ctx, art.Artifactory.UsernameSecret.Name, art.Artifactory.UsernameSecret.Key)
+	if art.Git.UsernameSecret != nil {
+		usernameBytes, err := ri.GetSecret(ctx, art.Git.UsernameSecret.Name, art.Git.UsernameSecret.Key)
 		if err != nil {
 			return nil, err
 		}
-		passwordBytes, err := ri.GetSecret(ctx, art.Artifactory.PasswordSecret.Name, art.Artifactory.PasswordSecret.Key)
+		gitDriver.Username = usernameBytes
+	}
+	if art.Git.PasswordSecret != nil {
+		passwordBytes, err := ri.GetSecret(ctx, art.Git.PasswordSecret.Name, art.Git.PasswordSecret.Key)
 		if err != nil {
 			return nil, err
 		}
-		driver := artifactory.ArtifactDriver{
-			Username: usernameBytes,
-			Password: passwordBytes,
+		gitDriver.Password = passwordBytes
+	}
+	if art.Git.SSHPrivateKeySecret != nil {
+		sshPrivateKeyBytes, err := ri.GetSecret(ctx, art.Git.SSHPrivateKeySecret.Name, art.Git.SSHPrivateKeySecret.Key)
+		if err != nil {
+			return nil, err
 		}
-		return &driver, nil
+		gitDriver.SSHPrivateKey = sshPrivateKeyBytes
+	}
 
+	return &gitDriver, nil
+}
+
+func newArtifactoryDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	usernameBytes, err := ri.GetSecret(ctx, art.Artifactory.UsernameSecret.Name, art.Artifactory.UsernameSecret.Key)
+	if err != nil {
+		return nil, err
 	}
-	if art.HDFS != nil {
-		return hdfs.CreateDriver(ctx, ri, art.HDFS)
+	passwordBytes, err := ri.GetSecret(ctx, art.Artifactory.PasswordSecret.Name, art.Artifactory.PasswordSecret.Key)
+	if err != nil {
+		return nil, err
 	}
-	if art.Raw != nil {
-		return &raw.ArtifactDriver{}, nil
+	driver := artifactory.ArtifactDriver{
+		Username: usernameBytes,
+		Password: passwordBytes,
 	}
+	return &driver, nil
+}
 
-	if art.OSS != nil {
-		var accessKey string
-		var secretKey string
+func newHDFSDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	return hdfs.CreateDriver(ctx, ri, art.HDFS)
+}
 
-		if art.OSS.AccessKeySecret != nil && art.OSS.AccessKeySecret.Name != "" {
-			accessKeyBytes, err := ri.GetSecret(ctx, art.OSS.AccessKeySecret.Name, art.OSS.AccessKeySecret.Key)
-			if err != nil {
-				return nil, err
-			}
-			accessKey = string(accessKeyBytes)
-			secretKeyBytes, err := ri.GetSecret(ctx, art.OSS.SecretKeySecret.Name, art.OSS.SecretKeySecret.Key)
-			if err != nil {
-				return nil, err
-			}
-			secretKey = string(secretKeyBytes)
-		}
+func newRawDriver(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+	return &raw.ArtifactDriver{}, nil
+}
 
-		driver := oss.ArtifactDriver{
-			Endpoint:      art.OSS.Endpoint,
-			AccessKey:     accessKey,
-			SecretKey:     secretKey,
-			SecurityToken: art.OSS.SecurityToken,
+func newOSSDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	var accessKey string
+	var secretKey string
+
+	if art.OSS.AccessKeySecret != nil && art.OSS.AccessKeySecret.Name != "" {
+		accessKeyBytes, err := ri.GetSecret(ctx, art.OSS.AccessKeySecret.Name, art.OSS.AccessKeySecret.Key)
+		if err != nil {
+			return nil, err
 		}
-		return &driver, nil
+		accessKey = string(accessKeyBytes)
+		secretKeyBytes, err := ri.GetSecret(ctx, art.OSS.SecretKeySecret.Name, art.OSS.SecretKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		secretKey = string(secretKeyBytes)
 	}
 
-	if art.GCS != nil {
-		driver := gcs.ArtifactDriver{}
-		if art.GCS.ServiceAccountKeySecret != nil && art.GCS.ServiceAccountKeySecret.Name != "" {
-			serviceAccountKeyBytes, err := ri.GetSecret(ctx, art.GCS.ServiceAccountKeySecret.Name, art.GCS.ServiceAccountKeySecret.Key)
-			if err != nil {
-				return nil, err
-			}
-			serviceAccountKey := string(serviceAccountKeyBytes)
-			driver.ServiceAccountKey = serviceAccountKey
+	driver := oss.ArtifactDriver{
+		Endpoint:      art.OSS.Endpoint,
+		AccessKey:     accessKey,
+		SecretKey:     secretKey,
+		SecurityToken: art.OSS.SecurityToken,
+	}
+	return &driver, nil
+}
+
+func newGCSDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	driver := gcs.ArtifactDriver{}
+	if art.GCS.ServiceAccountKeySecret != nil && art.GCS.ServiceAccountKeySecret.Name != "" {
+		serviceAccountKeyBytes, err := ri.GetSecret(ctx, art.GCS.ServiceAccountKeySecret.Name, art.GCS.ServiceAccountKeySecret.Key)
+		if err != nil {
+			return nil, err
 		}
-		// key is not set, assume it is using Workload Idendity
-		return &driver, nil
+		serviceAccountKey := string(serviceAccountKeyBytes)
+		driver.ServiceAccountKey = serviceAccountKey
 	}
+	// key is not set, assume it is using Workload Idendity
+	return &driver, nil
+}
 
-	return nil, ErrUnsupportedDriver
+func newAzureDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	driver := azure.ArtifactDriver{
+		UseSDKCreds: art.Azure.UseSDKCreds,
+	}
+	if art.Azure.AccountKeySecret != nil && art.Azure.AccountKeySecret.Name != "" {
+		accountKey, err := ri.GetSecret(ctx, art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		driver.AccountKey = accountKey
+	}
+	return &driver, nil
 }
@@ -0,0 +1,93 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/raw"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/s3"
+)
+
+func TestNewDriver(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := NewDriver(ctx, &wfv1.Artifact{}, nil)
+		assert.Equal(t, ErrUnsupportedDriver, err)
+	})
+	t.Run("S3", func(t *testing.T) {
+		driver, err := NewDriver(ctx, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{}}}, nil)
+		if assert.NoError(t, err) {
+			assert.IsType(t, &s3.ArtifactDriver{}, driver)
+		}
+	})
+	t.Run("Registered", func(t *testing.T) {
+		defer RegisterDriver(&wfv1.RawArtifact{}, newRawDriver)
+		called := false
+		RegisterDriver(&wfv1.RawArtifact{}, func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+			called = true
+			return &raw.ArtifactDriver{}, nil
+		})
+		_, err := NewDriver(ctx, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{}}}, nil)
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+	t.Run("UnknownLocationType", func(t *testing.T) {
+		assert.Panics(t, func() {
+			RegisterDriver(&unknownLocation{}, newRawDriver)
+		})
+	})
+	t.Run("MultipleLocations", func(t *testing.T) {
+		driver, err := NewDriver(ctx, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{
+			S3:  &wfv1.S3Artifact{},
+			Raw: &wfv1.RawArtifact{},
+		}}, nil)
+		if assert.NoError(t, err) {
+			assert.IsType(t, &raw.ArtifactDriver{}, driver)
+		}
+		driver, err = NewDriver(ctx, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{
+			S3:    &wfv1.S3Artifact{},
+			Raw:   &wfv1.RawArtifact{},
+			Azure: &wfv1.AzureArtifact{},
+		}}, nil)
+		if assert.NoError(t, err) {
+			assert.IsType(t, &azure.ArtifactDriver{}, driver)
+		}
+	})
+}
+
+type unknownLocation struct {
+	wfv1.RawArtifact
+}
+
+type deletingDriver struct {
+	raw.ArtifactDriver
+	deleted []string
+}
+
+func (d *deletingDriver) Delete(artifact *wfv1.Artifact) error {
+	d.deleted = append(d.deleted, artifact.Name)
+	return nil
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	ctx := context.Background()
+	art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{}}}
+	t.Run("Unsupported", func(t *testing.T) {
+		assert.Equal(t, ErrDeleteUnsupported, DeleteArtifact(ctx, art, nil))
+	})
+	t.Run("Supported", func(t *testing.T) {
+		defer RegisterDriver(&wfv1.RawArtifact{}, newRawDriver)
+		driver := &deletingDriver{}
+		RegisterDriver(&wfv1.RawArtifact{}, func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+			return driver, nil
+		})
+		assert.NoError(t, DeleteArtifact(ctx, art, nil))
+		assert.Equal(t, []string{"my-art"}, driver.deleted)
+	})
+}
@@ -0,0 +1,486 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+const (
+	// apiVersion is the Blob service REST API version used for all requests
+	apiVersion = "2020-04-08"
+	// imdsTokenURL is the Azure Instance Metadata Service endpoint used to obtain managed identity tokens
+	imdsTokenURL = "http://169.254.169.254/metadata/identity/oauth2/token"
+	// storageResource is the AAD resource identifier for Azure Storage
+	storageResource = "https://storage.azure.com/"
+	// imdsTimeout bounds each request for a managed identity token
+	imdsTimeout = 30 * time.Second
+	// tokenRefreshWindow is how long before expiry a managed identity token is refreshed
+	tokenRefreshWindow = 5 * time.Minute
+)
+
+// blockSize is the size of each block of a block upload. Files larger than this are uploaded in blocks,
+// as a single Put Blob request is limited in size and cannot be resumed.
+var blockSize int64 = 8 * 1024 * 1024
+
+// defaultClient times out connections and responses which stall, but not the transfer of a blob
+// body, which can take arbitrarily long for large artifacts.
+var defaultClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: time.Minute,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
+// ArtifactDriver is a driver for Azure Blob Storage
+type ArtifactDriver struct {
+	AccountKey  string
+	UseSDKCreds bool
+
+	client      *http.Client
+	imdsURL     string
+	token       string
+	tokenExpiry time.Time
+}
+
+var (
+	_ common.ArtifactDriver  = &ArtifactDriver{}
+	_ common.ArtifactDeleter = &ArtifactDriver{}
+)
+
+// Load downloads a blob, or every blob under the blob name as a directory, from Azure Blob Storage
+func (a *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	art := inputArtifact.Azure
+	log.Infof("Azure Load path: %s, container: %s, blob: %s", path, art.Container, art.Blob)
+	found, err := a.downloadBlob(art.AzureBlobContainer, art.Blob, path)
+	if err != nil || found {
+		return err
+	}
+	prefix := strings.TrimSuffix(art.Blob, "/") + "/"
+	names, err := a.listBlobs(art.AzureBlobContainer, prefix)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return errors.New(errors.CodeNotFound, fmt.Sprintf("blob %s not found in container %s", art.Blob, art.Container))
+	}
+	for _, name := range names {
+		localPath := filepath.Join(path, strings.TrimPrefix(name, prefix))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0o700); err != nil {
+			return err
+		}
+		if _, err := a.downloadBlob(art.AzureBlobContainer, name, localPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Save uploads a file, or every file under a directory, to Azure Blob Storage
+func (a *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	art := outputArtifact.Azure
+	log.Infof("Azure Save path: %s, container: %s, blob: %s", path, art.Container, art.Blob)
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return a.uploadBlob(art.AzureBlobContainer, art.Blob, path)
+	}
+	return filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(path, localPath)
+		if err != nil {
+			return err
+		}
+		return a.uploadBlob(art.AzureBlobContainer, art.Blob+"/"+filepath.ToSlash(rel), localPath)
+	})
+}
+
+// ListObjects returns the names of the blobs under the artifact's blob name
+func (a *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	return a.listBlobs(artifact.Azure.AzureBlobContainer, artifact.Azure.Blob)
+}
+
+// Delete deletes a blob, or every blob under the blob name as a directory, from Azure Blob Storage
+func (a *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	art := artifact.Azure
+	log.Infof("Azure Delete container: %s, blob: %s", art.Container, art.Blob)
+	names, err := a.listBlobs(art.AzureBlobContainer, strings.TrimSuffix(art.Blob, "/")+"/")
+	if err != nil {
+		return err
+	}
+	for _, name := range append(names, art.Blob) {
+		if err := a.deleteBlob(art.AzureBlobContainer, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *ArtifactDriver) downloadBlob(container wfv1.AzureBlobContainer, blob, localPath string) (bool, error) {
+	u, err := containerURL(container)
+	if err != nil {
+		return false, err
+	}
+	u.Path = path.Join(u.Path, blob)
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	res, err := a.do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return false, errors.InternalErrorf("loading blob %s from Azure failed with reason: %s", blob, res.Status)
+	}
+	f, err := os.Create(localPath)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	_, err = io.Copy(f, res.Body)
+	return true, err
+}
+
+func (a *ArtifactDriver) uploadBlob(container wfv1.AzureBlobContainer, blob, localPath string) error {
+	u, err := containerURL(container)
+	if err != nil {
+		return err
+	}
+	u.Path = path.Join(u.Path, blob)
+	f, err := os.Open(filepath.Clean(localPath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() > blockSize {
+		return a.uploadBlocks(u, f, fi.Size())
+	}
+	req, err := http.NewRequest(http.MethodPut, u.String(), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = fi.Size()
+	if fi.Size() == 0 {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	return a.put(req, localPath)
+}
+
+// uploadBlocks uploads a file as a sequence of blocks and then commits the block list
+func (a *ArtifactDriver) uploadBlocks(u *url.URL, f *os.File, size int64) error {
+	blockList := &bytes.Buffer{}
+	blockList.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for i, offset := 0, int64(0); offset < size; i, offset = i+1, offset+blockSize {
+		// block IDs must all be the same length
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+		n := blockSize
+		if offset+n > size {
+			n = size - offset
+		}
+		blockURL := *u
+		blockURL.RawQuery = url.Values{"comp": {"block"}, "blockid": {blockID}}.Encode()
+		req, err := http.NewRequest(http.MethodPut, blockURL.String(), io.NewSectionReader(f, offset, n))
+		if err != nil {
+			return err
+		}
+		req.ContentLength = n
+		if err := a.put(req, f.Name()); err != nil {
+			return err
+		}
+		blockList.WriteString("<Latest>" + blockID + "</Latest>")
+	}
+	blockList.WriteString("</BlockList>")
+	blockListURL := *u
+	blockListURL.RawQuery = url.Values{"comp": {"blocklist"}}.Encode()
+	req, err := http.NewRequest(http.MethodPut, blockListURL.String(), blockList)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	return a.put(req, f.Name())
+}
+
+func (a *ArtifactDriver) put(req *http.Request, localPath string) error {
+	res, err := a.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusCreated {
+		return errors.InternalErrorf("saving file %s to Azure failed with reason: %s", localPath, res.Status)
+	}
+	return nil
+}
+
+func (a *ArtifactDriver) deleteBlob(container wfv1.AzureBlobContainer, blob string) error {
+	u, err := containerURL(container)
+	if err != nil {
+		return err
+	}
+	u.Path = path.Join(u.Path, blob)
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := a.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNotFound {
+		return errors.InternalErrorf("deleting blob %s from Azure failed with reason: %s", blob, res.Status)
+	}
+	return nil
+}
+
+type enumerationResults struct {
+	Blobs []struct {
+		Name string `xml:"Name"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (a *ArtifactDriver) listBlobs(container wfv1.AzureBlobContainer, prefix string) ([]string, error) {
+	var names []string
+	marker := ""
+	for {
+		u, err := containerURL(container)
+		if err != nil {
+			return nil, err
+		}
+		q := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if marker != "" {
+			q.Set("marker", marker)
+		}
+		u.RawQuery = q.Encode()
+		req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := a.do(req)
+		if err != nil {
+			return nil, err
+		}
+		var results enumerationResults
+		if res.StatusCode == http.StatusOK {
+			err = xml.NewDecoder(res.Body).Decode(&results)
+		} else {
+			err = errors.InternalErrorf("listing blobs in Azure container %s failed with reason: %s", container.Container, res.Status)
+		}
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range results.Blobs {
+			names = append(names, b.Name)
+		}
+		if results.NextMarker == "" {
+			return names, nil
+		}
+		marker = results.NextMarker
+	}
+}
+
+// do authorizes and sends a request to the Blob service
+func (a *ArtifactDriver) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", apiVersion)
+	if err := a.authorize(req); err != nil {
+		return nil, err
+	}
+	return a.httpClient().Do(req)
+}
+
+// authorize sets the Authorization header of a request, using either a managed identity token or a
+// Shared Key signature
+func (a *ArtifactDriver) authorize(req *http.Request) error {
+	if a.UseSDKCreds {
+		token, err := a.managedIdentityToken()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if a.AccountKey != "" {
+		key, err := base64.StdEncoding.DecodeString(a.AccountKey)
+		if err != nil {
+			return fmt.Errorf("Azure account key is not valid base64: %w", err)
+		}
+		account := accountName(req.URL)
+		mac := hmac.New(sha256.New, key)
+		_, _ = mac.Write([]byte(stringToSign(account, req)))
+		req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", account, base64.StdEncoding.EncodeToString(mac.Sum(nil))))
+	}
+	return nil
+}
+
+func (a *ArtifactDriver) httpClient() *http.Client {
+	if a.client == nil {
+		return defaultClient
+	}
+	return a.client
+}
+
+// managedIdentityToken gets a token for Azure Storage from the Instance Metadata Service.
+// If AZURE_CLIENT_ID is set, the token is requested for that user-assigned identity.
+// The token is cached, and refreshed shortly before it expires.
+func (a *ArtifactDriver) managedIdentityToken() (string, error) {
+	if a.token != "" && time.Now().Add(tokenRefreshWindow).Before(a.tokenExpiry) {
+		return a.token, nil
+	}
+	q := url.Values{"api-version": {"2018-02-01"}, "resource": {storageResource}}
+	if clientID := os.Getenv("AZURE_CLIENT_ID"); clientID != "" {
+		q.Set("client_id", clientID)
+	}
+	tokenURL := a.imdsURL
+	if tokenURL == "" {
+		tokenURL = imdsTokenURL
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), imdsTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL+"?"+q.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata", "true")
+	res, err := a.httpClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get managed identity token: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get managed identity token: %s", res.Status)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		// ExpiresOn is the expiry time of the token in seconds since the epoch
+		ExpiresOn string `json:"expires_on"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	expiresOn, err := strconv.ParseInt(token.ExpiresOn, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("managed identity token has invalid expiry %q: %w", token.ExpiresOn, err)
+	}
+	a.token = token.AccessToken
+	a.tokenExpiry = time.Unix(expiresOn, 0)
+	return a.token, nil
+}
+
+func containerURL(container wfv1.AzureBlobContainer) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSuffix(container.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure endpoint %q: %w", container.Endpoint, err)
+	}
+	u.Path = path.Join("/", u.Path, container.Container)
+	return u, nil
+}
+
+// accountName returns the storage account of a Blob service URL. The account is the first label of the
+// host name, or the first path segment for IP-style endpoints such as the Azurite emulator.
+func accountName(u *url.URL) string {
+	host := u.Hostname()
+	if net.ParseIP(host) == nil && host != "localhost" {
+		return strings.SplitN(host, ".", 2)[0]
+	}
+	return strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)[0]
+}
+
+// stringToSign returns the Shared Key string to sign for a request, see
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func stringToSign(account string, req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	h := req.Header
+	fields := []string{
+		req.Method,
+		h.Get("Content-Encoding"),
+		h.Get("Content-Language"),
+		contentLength,
+		h.Get("Content-MD5"),
+		h.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead
+		h.Get("If-Modified-Since"),
+		h.Get("If-Match"),
+		h.Get("If-None-Match"),
+		h.Get("If-Unmodified-Since"),
+		h.Get("Range"),
+	}
+	var msHeaders []string
+	for k, v := range h {
+		k = strings.ToLower(k)
+		if strings.HasPrefix(k, "x-ms-") {
+			msHeaders = append(msHeaders, k+":"+strings.TrimSpace(strings.Join(v, ",")))
+		}
+	}
+	sort.Strings(msHeaders)
+	resource := "/" + account + req.URL.EscapedPath()
+	query := req.URL.Query()
+	var params []string
+	for k, v := range query {
+		sort.Strings(v)
+		params = append(params, strings.ToLower(k)+":"+strings.Join(v, ","))
+	}
+	sort.Strings(params)
+	for _, p := range params {
+		resource += "\n" + p
+	}
+	return strings.Join(fields, "\n") + "\n" + strings.Join(msHeaders, "\n") + "\n" + resource
+}
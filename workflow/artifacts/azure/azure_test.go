@@ -0,0 +1,233 @@
+package azure
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// fakeBlobService is a minimal in-memory implementation of the Blob service
+type fakeBlobService struct {
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	blocks map[string][]byte
+}
+
+func (f *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey devstoreaccount1:") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/devstoreaccount1/my-container/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list":
+		var names []string
+		for n := range f.blobs {
+			if strings.HasPrefix(n, r.URL.Query().Get("prefix")) {
+				names = append(names, n)
+			}
+		}
+		sort.Strings(names)
+		_, _ = fmt.Fprint(w, "<EnumerationResults><Blobs>")
+		for _, n := range names {
+			_, _ = fmt.Fprintf(w, "<Blob><Name>%s</Name></Blob>", n)
+		}
+		_, _ = fmt.Fprint(w, "</Blobs><NextMarker/></EnumerationResults>")
+	case r.Method == http.MethodGet:
+		data, ok := f.blobs[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case r.Method == http.MethodPut && r.URL.Query().Get("comp") == "block":
+		data, _ := ioutil.ReadAll(r.Body)
+		f.blocks[name+"#"+r.URL.Query().Get("blockid")] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && r.URL.Query().Get("comp") == "blocklist":
+		var blockList struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&blockList); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, id := range blockList.Latest {
+			data = append(data, f.blocks[name+"#"+id]...)
+		}
+		f.blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		data, _ := ioutil.ReadAll(r.Body)
+		f.blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.blobs[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestArtifactDriver(t *testing.T) {
+	service := &fakeBlobService{blobs: map[string][]byte{}, blocks: map[string][]byte{}}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	driver := &ArtifactDriver{AccountKey: base64.StdEncoding.EncodeToString([]byte("my-key"))}
+	artifact := func(blob string) *wfv1.Artifact {
+		return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{
+			AzureBlobContainer: wfv1.AzureBlobContainer{Endpoint: server.URL + "/devstoreaccount1", Container: "my-container"},
+			Blob:               blob,
+		}}}
+	}
+	dir, err := ioutil.TempDir("", "azure")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	src := filepath.Join(dir, "src")
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+
+	t.Run("File", func(t *testing.T) {
+		assert.NoError(t, driver.Save(filepath.Join(src, "a.txt"), artifact("my-file")))
+		dst := filepath.Join(dir, "file")
+		if assert.NoError(t, driver.Load(artifact("my-file"), dst)) {
+			data, _ := ioutil.ReadFile(dst)
+			assert.Equal(t, "a", string(data))
+		}
+	})
+	t.Run("Blocks", func(t *testing.T) {
+		defer func(n int64) { blockSize = n }(blockSize)
+		blockSize = 4
+		large := filepath.Join(dir, "large.txt")
+		assert.NoError(t, ioutil.WriteFile(large, []byte("0123456789"), 0o600))
+		assert.NoError(t, driver.Save(large, artifact("my-large-file")))
+		assert.Len(t, service.blocks, 3)
+		dst := filepath.Join(dir, "large")
+		if assert.NoError(t, driver.Load(artifact("my-large-file"), dst)) {
+			data, _ := ioutil.ReadFile(dst)
+			assert.Equal(t, "0123456789", string(data))
+		}
+		assert.NoError(t, driver.Delete(artifact("my-large-file")))
+	})
+	t.Run("Directory", func(t *testing.T) {
+		assert.NoError(t, driver.Save(src, artifact("my-dir")))
+		names, err := driver.ListObjects(artifact("my-dir"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"my-dir/a.txt", "my-dir/sub/b.txt"}, names)
+		}
+		dst := filepath.Join(dir, "dir")
+		if assert.NoError(t, driver.Load(artifact("my-dir"), dst)) {
+			data, _ := ioutil.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+			assert.Equal(t, "b", string(data))
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, driver.Delete(artifact("my-file")))
+		assert.NoError(t, driver.Delete(artifact("my-dir")))
+		assert.NoError(t, driver.Delete(artifact("not-found")))
+		names, err := driver.ListObjects(artifact(""))
+		if assert.NoError(t, err) {
+			assert.Empty(t, names)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(artifact("not-found"), filepath.Join(dir, "not-found"))
+		if assert.Error(t, err) {
+			assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+		}
+	})
+}
+
+func TestAccountName(t *testing.T) {
+	for endpoint, account := range map[string]string{
+		"https://myaccount.blob.core.windows.net":    "myaccount",
+		"http://127.0.0.1:10000/devstoreaccount1":    "devstoreaccount1",
+		"http://localhost:10000/devstoreaccount1/c/": "devstoreaccount1",
+	} {
+		u, err := url.Parse(endpoint)
+		if assert.NoError(t, err) {
+			assert.Equal(t, account, accountName(u), endpoint)
+		}
+	}
+}
+
+func TestStringToSign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://myaccount.blob.core.windows.net/my-container?restype=container&comp=list&prefix=my-dir", nil)
+	if assert.NoError(t, err) {
+		req.Header.Set("x-ms-date", "Fri, 26 Jun 2015 23:39:12 GMT")
+		req.Header.Set("x-ms-version", "2020-04-08")
+		assert.Equal(t, "GET\n\n\n\n\n\n\n\n\n\n\n\nx-ms-date:Fri, 26 Jun 2015 23:39:12 GMT\nx-ms-version:2020-04-08\n/myaccount/my-container\ncomp:list\nprefix:my-dir\nrestype:container", stringToSign("myaccount", req))
+	}
+}
+
+func TestSharedKeySignature(t *testing.T) {
+	driver := &ArtifactDriver{AccountKey: base64.StdEncoding.EncodeToString([]byte("my-key"))}
+	req, err := http.NewRequest(http.MethodPut, "https://myaccount.blob.core.windows.net/my-container/my-blob", strings.NewReader("hello"))
+	if assert.NoError(t, err) {
+		req.Header.Set("x-ms-date", "Fri, 26 Jun 2015 23:39:12 GMT")
+		req.Header.Set("x-ms-version", "2020-04-08")
+		req.Header.Set("x-ms-blob-type", "BlockBlob")
+		if assert.NoError(t, driver.authorize(req)) {
+			assert.Equal(t, "SharedKey myaccount:kP5+lKdibcIyemuNYMTzbaGftOIGykGrHYJuQ2JnYow=", req.Header.Get("Authorization"))
+		}
+	}
+	driver.AccountKey = "not base64!"
+	assert.Error(t, driver.authorize(req))
+}
+
+func TestManagedIdentityToken(t *testing.T) {
+	requests := 0
+	expiresIn := time.Hour
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" || r.URL.Query().Get("resource") != storageResource {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests++
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "expires_on": "%d"}`, requests, time.Now().Add(expiresIn).Unix())
+	}))
+	defer server.Close()
+	driver := &ArtifactDriver{UseSDKCreds: true, imdsURL: server.URL}
+	req, err := http.NewRequest(http.MethodGet, "https://myaccount.blob.core.windows.net/my-container/my-blob", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i := 0; i < 2; i++ {
+		if assert.NoError(t, driver.authorize(req)) {
+			assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"), "the token is cached")
+		}
+	}
+	// a token which is about to expire is refreshed
+	driver.tokenExpiry = time.Now().Add(time.Minute)
+	if assert.NoError(t, driver.authorize(req)) {
+		assert.Equal(t, "Bearer token-2", req.Header.Get("Authorization"))
+	}
+	assert.Equal(t, 2, requests)
+	assert.WithinDuration(t, time.Now().Add(expiresIn), driver.tokenExpiry, time.Minute)
+}
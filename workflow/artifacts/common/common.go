@@ -12,3 +12,10 @@ type ArtifactDriver interface {
 
 	ListObjects(artifact *v1alpha1.Artifact) ([]string, error)
 }
+
+// ArtifactDeleter is implemented by the drivers that can delete artifacts from their location
+type ArtifactDeleter interface {
+	// Delete deletes the artifact, including every object under it if it is a directory.
+	// Deleting an artifact that does not exist is not an error.
+	Delete(artifact *v1alpha1.Artifact) error
+}
@@ -28,7 +28,10 @@ type ArtifactDriver struct {
 	ServiceAccountKey string
 }
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ common.ArtifactDriver  = &ArtifactDriver{}
+	_ common.ArtifactDeleter = &ArtifactDriver{}
+)
 
 func (g *ArtifactDriver) newGCSClient() (*storage.Client, error) {
 	if g.ServiceAccountKey != "" {
@@ -263,3 +266,40 @@ func uploadObject(client *storage.Client, bucket, key, localPath string) error {
 func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
+
+// Delete deletes an object, or every object under the key as a directory, from GCS
+func (g *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("GCS Delete key: %s", artifact.GCS.Key)
+			gcsClient, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return false, err
+			}
+			defer gcsClient.Close()
+			err = deleteObjects(gcsClient, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				log.Warnf("Failed to delete objects from GCS: %v", err)
+				return false, err
+			}
+			return true, nil
+		})
+	return err
+}
+
+// delete the object of a key, and all the objects under it, from the bucket
+func deleteObjects(client *storage.Client, bucket, key string) error {
+	objNames, err := listByPrefix(client, bucket, strings.TrimSuffix(key, "/")+"/", "")
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, objName := range append(objNames, key) {
+		err = client.Bucket(bucket).Object(objName).Delete(ctx)
+		if err != nil && err != storage.ErrObjectNotExist {
+			return fmt.Errorf("delete %s: %v", objName, err)
+		}
+	}
+	return nil
+}
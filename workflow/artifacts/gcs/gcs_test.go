@@ -0,0 +1,71 @@
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+)
+
+// fakeGCS is a minimal in-memory implementation of the parts of the GCS JSON API used to delete objects
+type fakeGCS struct {
+	mu      sync.Mutex
+	objects map[string]bool
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/storage/v1/b/my-bucket/o")
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		var items []map[string]string
+		for name := range f.objects {
+			if strings.HasPrefix(name, r.URL.Query().Get("prefix")) {
+				items = append(items, map[string]string{"name": name, "bucket": "my-bucket"})
+			}
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i]["name"] < items[j]["name"] })
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"kind": "storage#objects", "items": items})
+	case r.Method == http.MethodDelete:
+		name := strings.TrimPrefix(path, "/")
+		if !f.objects[name] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestDeleteObjects(t *testing.T) {
+	gcs := &fakeGCS{objects: map[string]bool{
+		"my-file.tgz":       true,
+		"my-dir/a.txt":      true,
+		"my-dir/sub/b.txt":  true,
+		"my-dir-other.txt":  true,
+		"my-other-file.tgz": true,
+	}}
+	server := httptest.NewServer(gcs)
+	defer server.Close()
+	client, err := storage.NewClient(context.Background(), option.WithEndpoint(server.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer client.Close()
+
+	assert.NoError(t, deleteObjects(client, "my-bucket", "my-file.tgz"))
+	assert.NoError(t, deleteObjects(client, "my-bucket", "my-dir"))
+	assert.NoError(t, deleteObjects(client, "my-bucket", "not-found"), "deleting an object which does not exist is not an error")
+	assert.Equal(t, map[string]bool{"my-dir-other.txt": true, "my-other-file.tgz": true}, gcs.objects)
+}
@@ -13,7 +13,11 @@ import (
 )
 
 // ArtifactDriver is the artifact driver for a HTTP URL
-type ArtifactDriver struct{}
+type ArtifactDriver struct {
+	Username    string
+	Password    string
+	BearerToken string
+}
 
 var _ common.ArtifactDriver = &ArtifactDriver{}
 
@@ -27,7 +31,22 @@ func (h *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 		args = append(args, "-H", fmt.Sprintf("%s: %s", v.Name, v.Value))
 	}
 	log.Info(strings.Join(append([]string{"curl"}, args...), " "))
+	// credentials are read by curl from a config on stdin, rather than passed as arguments, so that they appear
+	// neither in the executor logs nor in the process list
+	var config []string
+	if h.Username != "" || h.Password != "" {
+		config = append(config, "user = "+quoteCurlConfig(h.Username+":"+h.Password))
+	}
+	if h.BearerToken != "" {
+		config = append(config, "header = "+quoteCurlConfig("Authorization: Bearer "+h.BearerToken))
+	}
+	if len(config) > 0 {
+		args = append(args, "-K", "-")
+	}
 	cmd := exec.Command("curl", args...)
+	if len(config) > 0 {
+		cmd.Stdin = strings.NewReader(strings.Join(config, "\n") + "\n")
+	}
 	output, err := cmd.CombinedOutput()
 	log.Info(string(output))
 	if err != nil {
@@ -44,6 +63,13 @@ func (h *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	return nil
 }
 
+// quoteCurlConfig quotes a value for a curl config file, see https://curl.se/docs/manpage.html#-K
+func quoteCurlConfig(s string) string {
+	return `"` + curlConfigEscaper.Replace(s) + `"`
+}
+
+var curlConfigEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\t", `\t`, "\n", `\n`, "\r", `\r`, "\v", `\v`)
+
 func (h *ArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "HTTP output artifacts unsupported")
 }
@@ -2,6 +2,8 @@ package http
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"testing"
@@ -66,6 +68,65 @@ func TestHTTPArtifactDriver_Load(t *testing.T) {
 	})
 }
 
+func TestHTTPArtifactDriver_LoadWithAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/basic":
+			if username, password, ok := r.BasicAuth(); !ok || username != "my-username" || password != "my-password" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		case "/basic-special-characters":
+			if username, password, ok := r.BasicAuth(); !ok || username != "my-username" || password != `my-"pass\word` {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		case "/bearer":
+			if r.Header.Get("Authorization") != "Bearer my-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}
+	}))
+	defer server.Close()
+	t.Run("BasicAuth", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-username", Password: "my-password"}
+		output := captureOutput(func() {
+			err := driver.Load(&wfv1.Artifact{
+				ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/basic"}},
+			}, "/tmp/found-with-basic-auth")
+			assert.NoError(t, err)
+		})
+		assert.NotContains(t, output, "my-password")
+	})
+	t.Run("BasicAuthSpecialCharacters", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-username", Password: `my-"pass\word`}
+		err := driver.Load(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/basic-special-characters"}},
+		}, "/tmp/found-with-basic-auth-special-characters")
+		assert.NoError(t, err)
+	})
+	t.Run("BearerToken", func(t *testing.T) {
+		driver := &ArtifactDriver{BearerToken: "my-token"}
+		output := captureOutput(func() {
+			err := driver.Load(&wfv1.Artifact{
+				ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/bearer"}},
+			}, "/tmp/found-with-bearer-token")
+			assert.NoError(t, err)
+		})
+		assert.NotContains(t, output, "my-token")
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		driver := &ArtifactDriver{}
+		err := driver.Load(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/bearer"}},
+		}, "/tmp/unauthorized")
+		assert.Error(t, err)
+	})
+}
+
+func TestQuoteCurlConfig(t *testing.T) {
+	assert.Equal(t, `"my-username:my-password"`, quoteCurlConfig("my-username:my-password"))
+	assert.Equal(t, `"my-\"pass\\word\n"`, quoteCurlConfig("my-\"pass\\word\n"))
+}
+
 func TestHTTPArtifactDriver_Save(t *testing.T) {
 	driver := &ArtifactDriver{}
 	assert.Error(t, driver.Save("", nil))
@@ -25,8 +25,9 @@ type ArtifactDriver struct {
 }
 
 var (
-	_            common.ArtifactDriver = &ArtifactDriver{}
-	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
+	_            common.ArtifactDriver  = &ArtifactDriver{}
+	_            common.ArtifactDeleter = &ArtifactDriver{}
+	defaultRetry                        = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
 
 	// OSS error code reference: https://error-center.alibabacloud.com/status/product/Oss
 	ossTransientErrorCodes = []string{"RequestTimeout", "QuotaExceeded.Refresh", "Default", "ServiceUnavailable", "Throttling", "RequestTimeTooSkewed", "SocketException", "SocketTimeout", "ServiceBusy", "DomainNetWorkVisitedException", "ConnectionTimeout", "CachedTimeTooLarge"}
@@ -153,6 +154,32 @@ func (ossDriver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 	return files, err
 }
 
+// Deletes an artifact from OSS compliant storage
+func (ossDriver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("OSS Delete key: %s", artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			// Directories are saved with a trailing slash, see Save.
+			objectName := strings.TrimSuffix(artifact.OSS.Key, "/")
+			for _, key := range []string{objectName, objectName + "/"} {
+				err = bucket.DeleteObject(key)
+				if err != nil {
+					return !isTransientOSSErr(err), err
+				}
+			}
+			return true, nil
+		})
+	return err
+}
+
 func setBucketLifecycleRule(client *oss.Client, ossArtifact *wfv1.OSSArtifact) error {
 	if ossArtifact.LifecycleRule.MarkInfrequentAccessAfterDays == 0 && ossArtifact.LifecycleRule.MarkDeletionAfterDays == 0 {
 		return nil
@@ -2,10 +2,15 @@ package oss
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestIsTransientOSSErr(t *testing.T) {
@@ -22,3 +27,34 @@ func TestIsTransientOSSErr(t *testing.T) {
 
 	assert.False(t, isTransientOSSErr(nil))
 }
+
+func TestDelete(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		deleted = append(deleted, r.URL.Path)
+		// OSS does not return an error when deleting an object which does not exist
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	driver := &ArtifactDriver{Endpoint: server.URL, AccessKey: "my-access-key", SecretKey: "my-secret-key"}
+	for _, key := range []string{"my-file.tgz", "my-dir/"} {
+		err := driver.Delete(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				OSS: &wfv1.OSSArtifact{
+					OSSBucket: wfv1.OSSBucket{Bucket: "my-bucket"},
+					Key:       key,
+				},
+			},
+		})
+		assert.NoError(t, err)
+	}
+	// directories are saved as a single object with a trailing slash, so both are deleted whatever the key
+	assert.Equal(t, []string{"/my-bucket/my-file.tgz", "/my-bucket/my-file.tgz/", "/my-bucket/my-dir", "/my-bucket/my-dir/"}, deleted)
+}
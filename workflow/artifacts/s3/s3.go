@@ -3,12 +3,15 @@ package s3
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/argoproj/pkg/file"
 	argos3 "github.com/argoproj/pkg/s3"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -32,8 +35,9 @@ type ArtifactDriver struct {
 }
 
 var (
-	_            artifactscommon.ArtifactDriver = &ArtifactDriver{}
-	defaultRetry                                = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
+	_            artifactscommon.ArtifactDriver  = &ArtifactDriver{}
+	_            artifactscommon.ArtifactDeleter = &ArtifactDriver{}
+	defaultRetry                                 = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
 )
 
 // newMinioClient instantiates a new minio client object.
@@ -51,6 +55,25 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 	return argos3.NewS3Client(ctx, opts)
 }
 
+// newMinioClient instantiates a minio client for the operations argos3.S3Client does not support.
+// Credentials are the access keys if set, otherwise the environment, shared credentials file or IAM role.
+func (s3Driver *ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	if s3Driver.RoleARN != "" {
+		return nil, fmt.Errorf("roleARN is not supported when deleting S3 artifacts")
+	}
+	var creds *credentials.Credentials
+	if s3Driver.AccessKey != "" {
+		creds = credentials.NewStaticV4(s3Driver.AccessKey, s3Driver.SecretKey, "")
+	} else {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+		})
+	}
+	return minio.New(s3Driver.Endpoint, &minio.Options{Creds: creds, Secure: s3Driver.Secure, Region: s3Driver.Region})
+}
+
 // Load downloads artifacts from S3 compliant storage
 func (s3Driver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -166,3 +189,48 @@ func (s3Driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 
 	return files, err
 }
+
+// Delete deletes an artifact, or every object under its key if it is a directory, from S3 compliant storage
+func (s3Driver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("S3 Delete key: %s", artifact.S3.Key)
+			client, err := s3Driver.newMinioClient()
+			if err != nil {
+				return true, fmt.Errorf("failed to create new S3 client: %v", err)
+			}
+			return deleteS3Artifact(ctx, client, artifact)
+		})
+}
+
+// objectDeleter is the part of the minio client used to delete artifacts
+type objectDeleter interface {
+	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+	RemoveObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+}
+
+var _ objectDeleter = &minio.Client{}
+
+// deleteS3Artifact deletes an artifact from an S3 compliant storage
+// returns true if the deletion is completed or can't be retried (non-transient error)
+// returns false if it can be retried (transient error)
+func deleteS3Artifact(ctx context.Context, client objectDeleter, artifact *wfv1.Artifact) (bool, error) {
+	bucket, key := artifact.S3.Bucket, artifact.S3.Key
+	// The key might be a s3 "directory", so delete every object under it too
+	prefix := strings.TrimSuffix(key, "/") + "/"
+	for object := range client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return !isTransientS3Err(object.Err), fmt.Errorf("failed to list directory: %v", object.Err)
+		}
+		if err := client.RemoveObject(ctx, bucket, object.Key, minio.RemoveObjectOptions{}); err != nil {
+			return !isTransientS3Err(err), fmt.Errorf("failed to delete %s: %v", object.Key, err)
+		}
+	}
+	if err := client.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return !isTransientS3Err(err), fmt.Errorf("failed to delete %s: %v", key, err)
+	}
+	return true, nil
+}
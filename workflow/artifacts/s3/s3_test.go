@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -97,6 +98,37 @@ func (s *mockS3Client) BucketExists(bucket string) (bool, error) {
 	return false, err
 }
 
+// ListObjects lists the objects of a bucket under a prefix
+func (s *mockS3Client) ListObjects(_ context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	objects := make(chan minio.ObjectInfo, len(s.files[bucketName])+1)
+	defer close(objects)
+	if err := s.getMockedErr("ListObjects"); err != nil {
+		objects <- minio.ObjectInfo{Err: err}
+		return objects
+	}
+	for _, file := range s.files[bucketName] {
+		if strings.HasPrefix(file, opts.Prefix) {
+			objects <- minio.ObjectInfo{Key: file}
+		}
+	}
+	return objects
+}
+
+// RemoveObject removes an object from a bucket, removing an object which does not exist is not an error
+func (s *mockS3Client) RemoveObject(_ context.Context, bucketName, objectName string, _ minio.RemoveObjectOptions) error {
+	if err := s.getMockedErr("RemoveObject"); err != nil {
+		return err
+	}
+	var files []string
+	for _, file := range s.files[bucketName] {
+		if file != objectName {
+			files = append(files, file)
+		}
+	}
+	s.files[bucketName] = files
+	return nil
+}
+
 // MakeBucket creates a bucket with name bucketName and options opts
 func (s *mockS3Client) MakeBucket(bucketName string, opts minio.MakeBucketOptions) error {
 	return s.getMockedErr("MakeBucket")
@@ -359,3 +391,69 @@ func TestSaveS3Artifact(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteS3Artifact(t *testing.T) {
+	tests := map[string]struct {
+		files     []string
+		key       string
+		errs      map[string]error
+		remaining []string
+		done      bool
+		errMsg    string
+	}{
+		"File": {
+			files:     []string{"folder/hello-art.tar.gz", "folder/other.tar.gz"},
+			key:       "folder/hello-art.tar.gz",
+			remaining: []string{"folder/other.tar.gz"},
+			done:      true,
+		},
+		"Directory": {
+			files:     []string{"folder/dir/a.txt", "folder/dir/sub/b.txt", "folder/dir-other.txt"},
+			key:       "folder/dir",
+			remaining: []string{"folder/dir-other.txt"},
+			done:      true,
+		},
+		"Not Found": {
+			files:     []string{"folder/other.tar.gz"},
+			key:       "folder/hello-art.tar.gz",
+			remaining: []string{"folder/other.tar.gz"},
+			done:      true,
+		},
+		"List Transient Error": {
+			files:     []string{"folder/dir/a.txt"},
+			key:       "folder/dir",
+			errs:      map[string]error{"ListObjects": minio.ErrorResponse{Code: "InternalError"}},
+			remaining: []string{"folder/dir/a.txt"},
+			done:      false,
+			errMsg:    "failed to list directory: We encountered an internal error, please try again.",
+		},
+		"Remove Error": {
+			files:     []string{"folder/hello-art.tar.gz"},
+			key:       "folder/hello-art.tar.gz",
+			errs:      map[string]error{"RemoveObject": minio.ErrorResponse{Code: "AccessDenied"}},
+			remaining: []string{"folder/hello-art.tar.gz"},
+			done:      true,
+			errMsg:    "failed to delete folder/hello-art.tar.gz: Access Denied.",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockS3Client(map[string][]string{"my-bucket": tc.files}, tc.errs)
+			done, err := deleteS3Artifact(context.Background(), client, &wfv1.Artifact{
+				ArtifactLocation: wfv1.ArtifactLocation{
+					S3: &wfv1.S3Artifact{
+						S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"},
+						Key:      tc.key,
+					},
+				},
+			})
+			assert.Equal(t, tc.done, done)
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tc.remaining, client.files["my-bucket"])
+		})
+	}
+}
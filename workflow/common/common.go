@@ -26,6 +26,12 @@ const (
 
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyArtifactBytesUploaded is the pod metadata annotation key containing the total size of the
+	// output artifacts uploaded by the executor
+	AnnotationKeyArtifactBytesUploaded = workflow.WorkflowFullName + "/artifact-bytes-uploaded"
+	// AnnotationKeyExecutorError is the pod metadata annotation key containing a summary of the errors the executor
+	// encountered, distinguishing executor failures from failures of the user's code
+	AnnotationKeyExecutorError = workflow.WorkflowFullName + "/executor-error"
 	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time when the workflow
 	// was scheduled to run by CronWorkflow.
 	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
@@ -62,6 +68,12 @@ const (
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
+	// LabelKeyArtifactGC is a label applied to artifact GC pods, containing the name of the workflow whose
+	// artifacts they delete
+	LabelKeyArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+
+	// FinalizerArtifactGC is the finalizer added to workflows whose artifacts must be deleted when the workflow is deleted
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -102,6 +114,11 @@ const (
 	EnvVarKubeletInsecure = "ARGO_KUBELET_INSECURE"
 	// EnvVarArgoTrace is used enable tracing statements in Argo components
 	EnvVarArgoTrace = "ARGO_TRACE"
+	// EnvVarArtifactStorageRemaining is the number of bytes of artifact storage the pod may still upload to, unset
+	// if there is no limit
+	EnvVarArtifactStorageRemaining = "ARGO_ARTIFACT_STORAGE_REMAINING"
+	// EnvVarArtifacts is the JSON list of artifacts an artifact GC pod deletes
+	EnvVarArtifacts = "ARGO_ARTIFACTS"
 
 	// ContainerRuntimeExecutorDocker to use docker as container runtime executor
 	ContainerRuntimeExecutorDocker = "docker"
@@ -334,3 +334,7 @@ func GetTemplateHolderString(tmplHolder wfv1.TemplateReferenceHolder) string {
 func GenerateOnExitNodeName(parentNodeName string) string {
 	return fmt.Sprintf("%s.onExit", parentNodeName)
 }
+
+func GenerateLifecycleHookNodeName(parentNodeName string, event string) string {
+	return fmt.Sprintf("%s.hooks.%s", parentNodeName, event)
+}
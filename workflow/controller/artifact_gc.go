@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const (
+	// artifactGCRequeueDuration is how often a workflow being deleted is checked while its artifacts are deleted
+	artifactGCRequeueDuration = 10 * time.Second
+	// artifactGCPodActiveDeadlineSeconds limits how long an artifact GC pod may run for
+	artifactGCPodActiveDeadlineSeconds = int64(10 * 60)
+	// artifactGCTimeout is how long after a workflow is deleted the controller gives up deleting its artifacts, so
+	// that a pod which cannot be created or scheduled does not prevent the workflow from being deleted
+	artifactGCTimeout = 15 * time.Minute
+)
+
+// artifactGCPodName returns the name of the pod which deletes the artifacts of a workflow with the given strategy
+func artifactGCPodName(workflowName string, strategy wfv1.ArtifactGCStrategy) string {
+	if strategy == wfv1.ArtifactGCOnWorkflowDeletion {
+		return workflowName + "-artgc-deletion"
+	}
+	return workflowName + "-artgc-completion"
+}
+
+// artifactGCStrategy returns the strategy used to delete an output artifact, the artifact's own strategy takes
+// precedence over the workflow's
+func (woc *wfOperationCtx) artifactGCStrategy(art wfv1.Artifact) wfv1.ArtifactGCStrategy {
+	return art.GetArtifactGCStrategy(woc.execWf.Spec.ArtifactGC)
+}
+
+// artifactsToGC returns the output artifacts of the workflow's nodes which are deleted with the given strategy.
+// Artifacts passed on to a parent node's outputs are only returned once.
+func (woc *wfOperationCtx) artifactsToGC(strategy wfv1.ArtifactGCStrategy) []wfv1.Artifact {
+	nodeIDs := make([]string, 0, len(woc.wf.Status.Nodes))
+	for nodeID := range woc.wf.Status.Nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+	locations := make(map[string]bool)
+	var artifacts []wfv1.Artifact
+	for _, nodeID := range nodeIDs {
+		outputs := woc.wf.Status.Nodes[nodeID].Outputs
+		if outputs == nil {
+			continue
+		}
+		for _, art := range outputs.Artifacts {
+			if !art.HasLocation() || woc.artifactGCStrategy(art) != strategy {
+				continue
+			}
+			location := wfv1.MustMarshallJSON(art.ArtifactLocation)
+			if !locations[location] {
+				locations[location] = true
+				artifacts = append(artifacts, art)
+			}
+		}
+	}
+	return artifacts
+}
+
+// hasArtifactGCOnDeletion returns whether any of the workflow's artifacts may be deleted on workflow deletion
+func (woc *wfOperationCtx) hasArtifactGCOnDeletion() bool {
+	if woc.execWf.Spec.ArtifactGC.GetStrategy() == wfv1.ArtifactGCOnWorkflowDeletion {
+		return true
+	}
+	templates := append([]wfv1.Template{}, woc.execWf.Spec.Templates...)
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		templates = append(templates, tmpl)
+	}
+	for _, tmpl := range templates {
+		for _, art := range tmpl.Outputs.Artifacts {
+			if art.ArtifactGC.GetStrategy() == wfv1.ArtifactGCOnWorkflowDeletion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addArtifactGCFinalizer adds the finalizer which prevents the workflow from being deleted before the artifacts
+// to delete on workflow deletion have been deleted
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if woc.wf.DeletionTimestamp != nil || slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC) || !woc.hasArtifactGCOnDeletion() {
+		return
+	}
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+// garbageCollectArtifacts deletes the artifacts of a completed workflow which are deleted on workflow completion
+func (woc *wfOperationCtx) garbageCollectArtifacts(ctx context.Context) {
+	artifacts := woc.artifactsToGC(wfv1.ArtifactGCOnWorkflowCompletion)
+	if len(artifacts) == 0 {
+		return
+	}
+	err := woc.createArtifactGCPod(ctx, wfv1.ArtifactGCOnWorkflowCompletion, artifacts)
+	if err != nil {
+		woc.log.WithError(err).Error("Failed to create artifact GC pod")
+		woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ArtifactGCFailed", fmt.Sprintf("Failed to create artifact GC pod: %v", err))
+	}
+}
+
+// garbageCollectArtifactsOnDeletion deletes the artifacts of a workflow being deleted which are deleted on workflow
+// deletion, and then removes the finalizer so the deletion can complete. It must be called repeatedly until the
+// finalizer is removed, the workflow is re-queued until then.
+func (woc *wfOperationCtx) garbageCollectArtifactsOnDeletion(ctx context.Context) {
+	if woc.wf.Status.StoredWorkflowSpec != nil {
+		woc.execWf = &wfv1.Workflow{Spec: *woc.wf.Status.StoredWorkflowSpec.DeepCopy()}
+	}
+	err := woc.controller.hydrator.Hydrate(woc.wf)
+	if err != nil {
+		woc.log.WithError(err).Error("Failed to hydrate workflow for artifact GC")
+		woc.requeueAfter(artifactGCRequeueDuration)
+		return
+	}
+	podName := artifactGCPodName(woc.wf.Name, wfv1.ArtifactGCOnWorkflowDeletion)
+	if time.Since(woc.wf.DeletionTimestamp.Time) > artifactGCTimeout {
+		woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ArtifactGCFailed", fmt.Sprintf("Gave up deleting artifacts after %v, see the logs of pod %s", artifactGCTimeout, podName))
+		woc.controller.queuePodForCleanup(woc.wf.Namespace, podName, deletePod)
+	} else if artifacts := woc.artifactsToGC(wfv1.ArtifactGCOnWorkflowDeletion); len(artifacts) > 0 {
+		pod, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace).Get(ctx, podName, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			err = woc.createArtifactGCPod(ctx, wfv1.ArtifactGCOnWorkflowDeletion, artifacts)
+			if err != nil {
+				woc.log.WithError(err).Error("Failed to create artifact GC pod")
+			}
+			woc.requeueAfter(artifactGCRequeueDuration)
+			return
+		}
+		if err != nil {
+			woc.log.WithError(err).Error("Failed to get artifact GC pod")
+			woc.requeueAfter(artifactGCRequeueDuration)
+			return
+		}
+		switch pod.Status.Phase {
+		case apiv1.PodSucceeded:
+		case apiv1.PodFailed:
+			// the workflow is deleted regardless, otherwise it can only be deleted by removing the finalizer by hand
+			woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ArtifactGCFailed", fmt.Sprintf("Failed to delete artifacts, see the logs of pod %s", podName))
+		default:
+			woc.requeueAfter(artifactGCRequeueDuration)
+			return
+		}
+		woc.controller.queuePodForCleanup(woc.wf.Namespace, podName, deletePod)
+	}
+	// update the workflow as it is in the informer, not the hydrated copy
+	wf := woc.orig.DeepCopy()
+	wf.Finalizers = slice.RemoveString(wf.Finalizers, common.FinalizerArtifactGC)
+	_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Update(ctx, wf, metav1.UpdateOptions{})
+	if err != nil {
+		woc.log.WithError(err).Warn("Failed to remove artifact GC finalizer")
+		woc.requeue()
+		return
+	}
+	woc.log.Info("Artifacts deleted, removed artifact GC finalizer")
+}
+
+// createArtifactGCPod creates the pod which deletes the artifacts, unless it already exists. The pod runs the
+// executor, with the secrets the artifacts' drivers need mounted as for the workflow's own pods.
+func (woc *wfOperationCtx) createArtifactGCPod(ctx context.Context, strategy wfv1.ArtifactGCStrategy, artifacts []wfv1.Artifact) error {
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{Artifacts: artifacts}}
+	ctr := woc.newExecContainer(common.MainContainerName, tmpl)
+	ctr.Command = []string{"argoexec", "artifact-gc", "--loglevel", woc.getExecutorLogLevel()}
+	ctr.Env = append(ctr.Env, apiv1.EnvVar{Name: common.EnvVarArtifacts, Value: wfv1.MustMarshallJSON(artifacts)})
+	volumes, volumeMounts := createSecretVolumes(tmpl)
+	ctr.VolumeMounts = append(ctr.VolumeMounts, volumeMounts...)
+
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      artifactGCPodName(woc.wf.Name, strategy),
+			Namespace: woc.wf.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyArtifactGC: woc.wf.Name,
+			},
+		},
+		Spec: apiv1.PodSpec{
+			RestartPolicy:         apiv1.RestartPolicyNever,
+			ActiveDeadlineSeconds: pointer.Int64Ptr(artifactGCPodActiveDeadlineSeconds),
+			Containers:            []apiv1.Container{*ctr},
+			Volumes:               append(woc.kubeConfigVolumes(), volumes...),
+			ImagePullSecrets:      woc.execWf.Spec.ImagePullSecrets,
+		},
+	}
+	// A pod deleting the artifacts of a workflow being deleted must not be deleted along with the workflow's
+	// dependents, so the controller deletes it once it completes instead.
+	if strategy != wfv1.ArtifactGCOnWorkflowDeletion {
+		pod.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+		}
+	}
+	if woc.controller.Config.InstanceID != "" {
+		pod.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	err := woc.setupServiceAccount(ctx, pod, tmpl)
+	if err != nil {
+		return err
+	}
+	_, err = woc.controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	woc.log.WithFields(log.Fields{"podName": pod.Name, "artifacts": len(artifacts)}).Info("Created artifact GC pod")
+	return nil
+}
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const artifactGCWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+    - name: main
+      container:
+        image: my-image
+      outputs:
+        artifacts:
+          - name: on-completion
+            path: /tmp/on-completion
+          - name: on-deletion
+            path: /tmp/on-deletion
+            artifactGC:
+              strategy: OnWorkflowDeletion
+          - name: never
+            path: /tmp/never
+            artifactGC: {}
+status:
+  nodes:
+    artifact-gc:
+      id: artifact-gc
+      name: artifact-gc
+      phase: Succeeded
+      type: Pod
+      outputs:
+        artifacts:
+          - name: on-completion
+            s3:
+              bucket: my-bucket
+              key: artifact-gc/on-completion.tgz
+          - name: on-deletion
+            s3:
+              bucket: my-bucket
+              key: artifact-gc/on-deletion.tgz
+            artifactGC:
+              strategy: OnWorkflowDeletion
+          - name: never
+            s3:
+              bucket: my-bucket
+              key: artifact-gc/never.tgz
+            artifactGC: {}
+    artifact-gc-parent:
+      id: artifact-gc-parent
+      name: artifact-gc-parent
+      phase: Succeeded
+      type: Steps
+      outputs:
+        artifacts:
+          - name: from-child
+            s3:
+              bucket: my-bucket
+              key: artifact-gc/on-completion.tgz
+`
+
+func artifactNames(artifacts []wfv1.Artifact) []string {
+	var names []string
+	for _, art := range artifacts {
+		names = append(names, art.Name)
+	}
+	return names
+}
+
+func TestArtifactsToGC(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	assert.Equal(t, []string{"on-completion"}, artifactNames(woc.artifactsToGC(wfv1.ArtifactGCOnWorkflowCompletion)))
+	assert.Equal(t, []string{"on-deletion"}, artifactNames(woc.artifactsToGC(wfv1.ArtifactGCOnWorkflowDeletion)))
+}
+
+func TestGarbageCollectArtifacts(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.garbageCollectArtifacts(ctx)
+	pod, err := getPod(woc, "artifact-gc-artgc-completion")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "artifact-gc", pod.Labels[common.LabelKeyArtifactGC])
+		assert.NotContains(t, pod.Labels, common.LabelKeyWorkflow)
+		assert.Len(t, pod.OwnerReferences, 1)
+		if assert.NotNil(t, pod.Spec.ActiveDeadlineSeconds) {
+			assert.Equal(t, artifactGCPodActiveDeadlineSeconds, *pod.Spec.ActiveDeadlineSeconds)
+		}
+		if assert.Len(t, pod.Spec.Containers, 1) {
+			ctr := pod.Spec.Containers[0]
+			assert.Equal(t, []string{"argoexec", "artifact-gc"}, ctr.Command[0:2])
+			var artifacts []wfv1.Artifact
+			for _, env := range ctr.Env {
+				if env.Name == common.EnvVarArtifacts {
+					wfv1.MustUnmarshal(env.Value, &artifacts)
+				}
+			}
+			assert.Equal(t, []string{"on-completion"}, artifactNames(artifacts))
+		}
+	}
+	// the pod is only created once
+	woc.garbageCollectArtifacts(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 1)
+	}
+}
+
+func TestArtifactGCFinalizer(t *testing.T) {
+	t.Run("OnWorkflowDeletion", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+		wf.Status = wfv1.WorkflowStatus{}
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(context.Background())
+		assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+	})
+	t.Run("OnWorkflowCompletion", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+		wf.Status = wfv1.WorkflowStatus{}
+		wf.Spec.Templates[0].Outputs.Artifacts = wf.Spec.Templates[0].Outputs.Artifacts[0:1]
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(context.Background())
+		assert.NotContains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+	})
+}
+
+func TestGarbageCollectArtifactsOnDeletion(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	wf.Finalizers = []string{common.FinalizerArtifactGC}
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.garbageCollectArtifactsOnDeletion(ctx)
+	pod, err := getPod(woc, "artifact-gc-artgc-deletion")
+	if assert.NoError(t, err) {
+		assert.Empty(t, pod.OwnerReferences)
+		expectWorkflow(ctx, controller, wf.Name, func(wf *wfv1.Workflow) {
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		})
+
+		pod.Status.Phase = apiv1.PodSucceeded
+		_, err = controller.kubeclientset.CoreV1().Pods(pod.Namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		woc = newWorkflowOperationCtx(wf, controller)
+		woc.garbageCollectArtifactsOnDeletion(ctx)
+		expectWorkflow(ctx, controller, wf.Name, func(wf *wfv1.Workflow) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		})
+	}
+}
+
+func TestGarbageCollectArtifactsOnDeletionFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	wf.Finalizers = []string{common.FinalizerArtifactGC}
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.garbageCollectArtifactsOnDeletion(ctx)
+	pod, err := getPod(woc, "artifact-gc-artgc-deletion")
+	if assert.NoError(t, err) {
+		pod.Status.Phase = apiv1.PodFailed
+		_, err = controller.kubeclientset.CoreV1().Pods(pod.Namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		woc = newWorkflowOperationCtx(wf, controller)
+		woc.garbageCollectArtifactsOnDeletion(ctx)
+		// the workflow can still be deleted
+		expectWorkflow(ctx, controller, wf.Name, func(wf *wfv1.Workflow) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		})
+		assert.Contains(t, getEvents(controller, 1)[0], "Warning ArtifactGCFailed")
+	}
+}
+
+func TestGarbageCollectArtifactsOnDeletionTimeout(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	wf.Finalizers = []string{common.FinalizerArtifactGC}
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-artifactGCTimeout - time.Minute)}
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.garbageCollectArtifactsOnDeletion(ctx)
+	// no pod is created, and the workflow can be deleted
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Empty(t, pods.Items)
+	}
+	expectWorkflow(ctx, controller, wf.Name, func(wf *wfv1.Workflow) {
+		assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+	})
+	assert.Contains(t, getEvents(controller, 1)[0], "Warning ArtifactGCFailed")
+}
@@ -2,21 +2,73 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/time/rate"
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
+// onConfigChange applies a changed configuration, recording a warning event against the config map if it is invalid
+func (wfc *WorkflowController) onConfigChange(v interface{}) error {
+	err := wfc.updateConfig(v)
+	if err != nil {
+		wfc.configError.Store(err.Error())
+		cm := &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: wfc.configMap, Namespace: wfc.namespace}}
+		wfc.eventRecorderManager.Get(wfc.namespace).Event(cm, apiv1.EventTypeWarning, "ConfigUpdateFailed", err.Error())
+	} else {
+		wfc.configError.Store("")
+	}
+	return err
+}
+
+// validateArtifactRepository returns an error if more than one artifact repository is configured, or if a secret that
+// the S3, GCS or Azure repository refers to is missing or empty. Secrets are only checked when the controller manages
+// a single namespace, otherwise they must exist in the namespace of each workflow rather than in one we can check.
+func (wfc *WorkflowController) validateArtifactRepository(repo *wfv1.ArtifactRepository, namespace string) error {
+	if err := repo.Validate(); err != nil {
+		return err
+	}
+	if namespace == "" {
+		return nil
+	}
+	var secrets []*apiv1.SecretKeySelector
+	if repo.S3 != nil {
+		secrets = append(secrets, repo.S3.AccessKeySecret, repo.S3.SecretKeySecret)
+	}
+	if repo.GCS != nil {
+		secrets = append(secrets, repo.GCS.ServiceAccountKeySecret)
+	}
+	if repo.Azure != nil {
+		secrets = append(secrets, repo.Azure.AccountKeySecret)
+	}
+	for _, secret := range secrets {
+		if secret == nil {
+			continue
+		}
+		val, err := util.GetSecrets(context.Background(), wfc.kubeclientset, namespace, secret.Name, secret.Key)
+		if err != nil {
+			return err
+		}
+		if len(val) == 0 {
+			return fmt.Errorf("secret '%s' key '%s' is empty", secret.Name, secret.Key)
+		}
+	}
+	return nil
+}
+
 func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	config := v.(*config.Config)
 	bytes, err := yaml.Marshal(config)
@@ -27,6 +79,18 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	if wfc.cliExecutorImage == "" && config.ExecutorImage == "" {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap does not have executorImage")
 	}
+	if config.ExecutorLogLevel != "" {
+		if _, err := log.ParseLevel(config.ExecutorLogLevel); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "ConfigMap executorLogLevel is invalid: %v", err)
+		}
+	}
+	managedNamespace := wfc.managedNamespace
+	if managedNamespace == "" {
+		managedNamespace = config.Namespace
+	}
+	if err := wfc.validateArtifactRepository(&config.ArtifactRepository, managedNamespace); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "ConfigMap artifactRepository is invalid: %v", err)
+	}
 	wfc.Config = *config
 	if wfc.session != nil {
 		err := wfc.session.Close()
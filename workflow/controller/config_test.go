@@ -1,11 +1,15 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -18,3 +22,57 @@ func TestUpdateConfig(t *testing.T) {
 	assert.NotNil(t, controller.wfArchive)
 	assert.NotNil(t, controller.offloadNodeStatusRepo)
 }
+
+func TestUpdateConfigExecutorLogLevel(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ExecutorLogLevel: "warn"})
+	assert.NoError(t, err)
+	err = controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ExecutorLogLevel: "loud"})
+	assert.EqualError(t, err, `ConfigMap executorLogLevel is invalid: not a valid logrus Level: "loud"`)
+}
+
+func TestUpdateConfigArtifactRepository(t *testing.T) {
+	cancel, controller := newController(func(controller *WorkflowController) {
+		controller.managedNamespace = "default"
+	})
+	defer cancel()
+	_, err := controller.kubeclientset.CoreV1().Secrets("default").Create(context.Background(), &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+		Data:       map[string][]byte{"my-key": []byte("my-value"), "my-empty-key": {}},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	gcs := func(key string) wfv1.ArtifactRepository {
+		return wfv1.ArtifactRepository{GCS: &wfv1.GCSArtifactRepository{GCSBucket: wfv1.GCSBucket{
+			Bucket:                  "my-bucket",
+			ServiceAccountKeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: key},
+		}}}
+	}
+	t.Run("GCS", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ArtifactRepository: gcs("my-key")})
+		assert.NoError(t, err)
+	})
+	t.Run("GCSMissingKey", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ArtifactRepository: gcs("my-missing-key")})
+		assert.EqualError(t, err, "ConfigMap artifactRepository is invalid: secret 'my-secret' does not have the key 'my-missing-key'")
+	})
+	t.Run("GCSEmptyKey", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ArtifactRepository: gcs("my-empty-key")})
+		assert.EqualError(t, err, "ConfigMap artifactRepository is invalid: secret 'my-secret' key 'my-empty-key' is empty")
+	})
+	t.Run("S3AndGCS", func(t *testing.T) {
+		repo := gcs("my-key")
+		repo.S3 = &wfv1.S3ArtifactRepository{}
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ArtifactRepository: repo})
+		assert.EqualError(t, err, "ConfigMap artifactRepository is invalid: only one artifact repository may be configured, but got gcs, s3")
+	})
+}
+
+func TestOnConfigChange(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	err := controller.onConfigChange(&config.Config{})
+	if assert.Error(t, err) {
+		assert.Equal(t, []string{"Warning ConfigUpdateFailed ConfigMap does not have executorImage"}, getEvents(controller, 1))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -45,6 +46,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/diff"
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
@@ -68,7 +70,14 @@ type WorkflowController struct {
 	namespace        string
 	managedNamespace string
 
+	// name of the workflow controller's config map
+	configMap        string
 	configController config.Controller
+	// configError is the error message from the most recent config map update, or "" if it succeeded
+	configError atomic.Value
+	// cachesSynced is true once the informers' caches have synced; the informers themselves are created by Run while
+	// readiness is already being served, so they must not be read to check it
+	cachesSynced atomic.Value
 	// Config is the workflow controller's configuration
 	Config config.Config
 	// get the artifact repository
@@ -135,6 +144,7 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		cliExecutorImage:           executorImage,
 		cliExecutorImagePullPolicy: executorImagePullPolicy,
 		containerRuntimeExecutor:   containerRuntimeExecutor,
+		configMap:                  configMap,
 		configController:           config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc),
 		workflowKeyLock:            syncpkg.NewKeyLock(),
 		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
@@ -212,7 +222,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	wfc.updateEstimatorFactory()
 
 	go wfc.runConfigMapWatcher(ctx.Done())
-	go wfc.configController.Run(ctx.Done(), wfc.updateConfig)
+	go wfc.configController.Run(ctx.Done(), wfc.onConfigChange)
 	go wfc.wfInformer.Run(ctx.Done())
 	go wfc.wftmplInformer.Informer().Run(ctx.Done())
 	go wfc.podInformer.Run(ctx.Done())
@@ -221,6 +231,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	if !cache.WaitForCacheSync(ctx.Done(), wfc.wfInformer.HasSynced, wfc.wftmplInformer.Informer().HasSynced, wfc.podInformer.HasSynced) {
 		log.Fatal("Timed out waiting for caches to sync")
 	}
+	wfc.cachesSynced.Store(true)
 
 	wfc.createClusterWorkflowTemplateInformer(ctx)
 
@@ -249,10 +260,17 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 		if wfc.Config.InstanceID != "" {
 			leaderName = fmt.Sprintf("%s-%s", leaderName, wfc.Config.InstanceID)
 		}
+		if v, ok := os.LookupEnv("LEADER_ELECTION_LEASE_NAME"); ok {
+			leaderName = v
+		}
+		leaseNamespace := wfc.namespace
+		if v, ok := os.LookupEnv("LEADER_ELECTION_LEASE_NAMESPACE"); ok {
+			leaseNamespace = v
+		}
 
 		go leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 			Lock: &resourcelock.LeaseLock{
-				LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: wfc.namespace}, Client: wfc.kubeclientset.CoordinationV1(),
+				LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: leaseNamespace}, Client: wfc.kubeclientset.CoordinationV1(),
 				LockConfig: resourcelock.ResourceLockConfig{Identity: nodeID, EventRecorder: wfc.eventRecorderManager.Get(wfc.namespace)},
 			},
 			ReleaseOnCancel: true,
@@ -312,6 +330,7 @@ func (wfc *WorkflowController) waitForCacheSync(ctx context.Context) {
 			panic("Timed out waiting for caches to sync")
 		}
 	}
+	wfc.cachesSynced.Store(true)
 }
 
 // Create and initialize the Synchronization Manager
@@ -715,6 +734,12 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 		return true
 	}
 
+	if wf.DeletionTimestamp != nil && slice.ContainsString(wf.Finalizers, common.FinalizerArtifactGC) {
+		// the workflow is being deleted, it is not operated on any more, just kept until its artifacts are deleted
+		newWorkflowOperationCtx(wf, wfc).garbageCollectArtifactsOnDeletion(ctx)
+		return true
+	}
+
 	if wf.Labels[common.LabelKeyCompleted] == "true" {
 		// can get here if we already added the completed=true label,
 		// but we are still draining the controller's workflow workqueue
@@ -772,6 +797,7 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 				woc.controller.queuePodForCleanupAfter(woc.wf.Namespace, podName, deletePod, delay)
 			}
 		}
+		woc.garbageCollectArtifacts(ctx)
 	}
 
 	// TODO: operate should return error if it was unable to operate properly
@@ -914,6 +940,28 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 		},
 	},
 	)
+	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			// completed workflows being deleted must still be processed to delete their artifacts
+			return ok && un.GetDeletionTimestamp() != nil && slice.ContainsString(un.GetFinalizers(), common.FinalizerArtifactGC)
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				key, err := cache.MetaNamespaceKeyFunc(obj)
+				if err == nil {
+					wfc.wfQueue.Add(key)
+				}
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				key, err := cache.MetaNamespaceKeyFunc(obj)
+				if err == nil {
+					wfc.wfQueue.Add(key)
+				}
+			},
+		},
+	},
+	)
 	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: func(obj interface{}) {
 			wf, ok := obj.(*unstructured.Unstructured)
@@ -54,6 +54,10 @@ type dagContext struct {
 	// Because this resolved "depends" is computed using regex and regex is expensive, we cache the results so that they
 	// are only computed once per operation
 	dependsLogic map[string]string
+
+	// hooksPending is set when a lifecycle hook of any task has been started but is not yet fulfilled. The DAG is not
+	// complete until all of its hooks are
+	hooksPending bool
 }
 
 func (d *dagContext) GetTaskDependencies(taskName string) []string {
@@ -261,14 +265,23 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		}
 	}
 
-	// check if we are still running any tasks in this dag and return early if we do
+	// check if we are still running any tasks or lifecycle hooks in this dag and return early if we do
+	if dagCtx.hooksPending {
+		return node, nil
+	}
 	dagPhase := dagCtx.assessDAGPhase(targetTasks, woc.wf.Status.Nodes)
+	var message []string
+	if crashed := woc.getCrashedDaemonedChild(node.ID); crashed != nil && dagPhase == wfv1.NodeSucceeded {
+		// the dependents of the daemon may have succeeded before it crashed, but the DAG must not
+		dagPhase = wfv1.NodeFailed
+		message = append(message, fmt.Sprintf("daemoned child '%s' crashed", crashed.Name))
+	}
 	switch dagPhase {
 	case wfv1.NodeRunning:
 		return node, nil
 	case wfv1.NodeError, wfv1.NodeFailed:
 		woc.updateOutboundNodesForTargetTasks(dagCtx, targetTasks, nodeName)
-		_ = woc.markNodePhase(nodeName, dagPhase)
+		_ = woc.markNodePhase(nodeName, dagPhase, message...)
 		return node, nil
 	}
 
@@ -340,6 +353,13 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			woc.controller.syncManager.Release(woc.wf, node.ID, tmpl.Synchronization)
 		}
 
+		// Hooks of expanded tasks are run for each of the expanded nodes, rather than for the task group.
+		if node.Type != wfv1.NodeTypeTaskGroup {
+			if !woc.runLifecycleHooks(ctx, task.Hooks, node, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName) {
+				dagCtx.hooksPending = true
+			}
+		}
+
 		if node.Completed() {
 			// Run the node's onExit node, if any.
 			hasOnExitNode, onExitNode, err := woc.runOnExitNode(ctx, task.GetExitHook(woc.execWf.Spec.Arguments), task.Name, node.Name, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName, node.Outputs)
@@ -457,7 +477,12 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 
 		// Finally execute the template
-		_, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		if node != nil {
+			if !woc.runLifecycleHooks(ctx, t.Hooks, node, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+t.Name) {
+				dagCtx.hooksPending = true
+			}
+		}
 		if err != nil {
 			switch err {
 			case ErrDeadlineExceeded:
@@ -3150,3 +3150,104 @@ func TestLeafContinueOn(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
 }
+
+const dagWithLifecycleHooks = `
+metadata:
+  name: hooks
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: whalesay
+            hooks:
+              succeeded:
+                template: notify
+    - name: whalesay
+      container:
+        image: docker/whalesay
+    - name: notify
+      container:
+        image: alpine
+`
+
+func TestDAGWithLifecycleHooks(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagWithLifecycleHooks)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.GetNodeByName("hooks.a.hooks.succeeded"))
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("hooks.a").Phase)
+	if hookNode := woc.wf.GetNodeByName("hooks.a.hooks.succeeded"); assert.NotNil(t, hookNode) {
+		assert.True(t, woc.wf.GetNodeByName("hooks.a").HasChild(hookNode.ID))
+	}
+	// the DAG waits for the hook to complete
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("hooks").Phase)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("hooks.a.hooks.succeeded").Phase)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+const dagWithCrashedDaemon = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: daemon
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: server
+            template: server
+          - name: client
+            template: client
+            dependencies: [server]
+    - name: server
+      daemon: true
+      container:
+        image: my-image
+    - name: client
+      container:
+        image: my-image
+`
+
+func TestDAGWithCrashedDaemon(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagWithCrashedDaemon)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, v1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.True(t, woc.wf.GetNodeByName("daemon.server").IsDaemoned())
+	assert.NotNil(t, woc.wf.GetNodeByName("daemon.client"))
+
+	makeNodePodPhase(ctx, t, woc, "daemon.server", v1.PodFailed)
+	makeNodePodPhase(ctx, t, woc, "daemon.client", v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("daemon.client").Phase)
+	if node := woc.wf.GetNodeByName("daemon"); assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "daemoned child 'daemon.server' crashed", node.Message)
+	}
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -63,7 +64,8 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 			}
 		}
 	}
-	if woc.GetShutdownStrategy().Enabled() {
+	// "Stop" only prevents new pods from running, so pods which are already running are left to complete
+	if woc.GetShutdownStrategy() == wfv1.ShutdownStrategyTerminate || (woc.GetShutdownStrategy() == wfv1.ShutdownStrategyStop && pod.Status.Phase == apiv1.PodPending) {
 		if _, onExitPod := pod.Labels[common.LabelKeyOnExit]; !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
 			woc.log.Infof("Shutting down pod %s", pod.Name)
 			woc.controller.queuePodForCleanup(woc.wf.Namespace, pod.Name, shutdownPod)
@@ -71,6 +73,43 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 	}
 }
 
+// getCrashedDaemonedChild returns a child of the boundary whose pod failed while it was daemoned, or nil if there is
+// none. The steps or tasks which followed it may have relied on the daemon, so the boundary must not succeed.
+func (woc *wfOperationCtx) getCrashedDaemonedChild(boundaryID string) *wfv1.NodeStatus {
+	var crashed []wfv1.NodeStatus
+	for _, node := range woc.wf.Status.Nodes {
+		if node.BoundaryID == boundaryID && node.DaemonCrashed && node.FailedOrError() {
+			crashed = append(crashed, node)
+		}
+	}
+	if len(crashed) == 0 {
+		return nil
+	}
+	sort.Slice(crashed, func(i, j int) bool { return crashed[i].Name < crashed[j].Name })
+	return &crashed[0]
+}
+
+// failUnfulfilledNodes shuts down the pods of every node which has not yet completed, including daemoned nodes, and
+// fails those nodes, so that the workflow stops when it cannot continue.
+func (woc *wfOperationCtx) failUnfulfilledNodes(message string) {
+	for _, node := range woc.wf.Status.Nodes {
+		if node.IsDaemoned() {
+			woc.controller.queuePodForCleanup(woc.wf.Namespace, node.ID, shutdownPod)
+			node.Daemoned = nil
+			woc.wf.Status.Nodes[node.ID] = node
+			woc.updated = true
+		}
+		if node.Fulfilled() {
+			continue
+		}
+		if node.Type == wfv1.NodeTypePod {
+			woc.log.Infof("Shutting down pod %s: %s", node.ID, message)
+			woc.controller.queuePodForCleanup(woc.wf.Namespace, node.ID, shutdownPod)
+		}
+		woc.markNodePhase(node.Name, wfv1.NodeFailed, message)
+	}
+}
+
 // killDaemonedChildren kill any daemoned pods of a steps or DAG template node.
 func (woc *wfOperationCtx) killDaemonedChildren(nodeID string) {
 	woc.log.Infof("Checking daemoned children of %s", nodeID)
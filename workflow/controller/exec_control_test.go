@@ -1,9 +1,14 @@
 package controller
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -30,3 +35,30 @@ func TestKillDaemonChildrenUnmarkPod(t *testing.T) {
 	woc.killDaemonedChildren("a")
 	assert.Nil(t, woc.wf.Status.Nodes["a"].Daemoned)
 }
+
+func TestApplyExecutionControlShutdownRunningPod(t *testing.T) {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "argo"},
+		Status:     apiv1.PodStatus{Phase: apiv1.PodRunning},
+	}
+	t.Run("Stop", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		woc := newWorkflowOperationCtx(&v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "wf", Namespace: "argo"},
+			Spec:       v1alpha1.WorkflowSpec{Shutdown: v1alpha1.ShutdownStrategyStop},
+		}, controller)
+		woc.applyExecutionControl(context.Background(), pod, &sync.RWMutex{})
+		assert.Never(t, func() bool { return controller.podCleanupQueue.Len() > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+	t.Run("Terminate", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		woc := newWorkflowOperationCtx(&v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "wf", Namespace: "argo"},
+			Spec:       v1alpha1.WorkflowSpec{Shutdown: v1alpha1.ShutdownStrategyTerminate},
+		}, controller)
+		woc.applyExecutionControl(context.Background(), pod, &sync.RWMutex{})
+		assert.Eventually(t, func() bool { return controller.podCleanupQueue.Len() == 1 }, time.Second, 10*time.Millisecond)
+	})
+}
@@ -90,3 +90,60 @@ func (woc *wfOperationCtx) resolveExitTmplArgument(args wfv1.Arguments, prefix s
 	}
 	return newArgs, nil
 }
+
+// runLifecycleHooks runs the hooks of a step or task which are triggered by the phase the node is in. Each hook runs at
+// most once per node. It returns false if any hook that has been started is not yet fulfilled.
+func (woc *wfOperationCtx) runLifecycleHooks(ctx context.Context, hooks wfv1.LifecycleHooks, node *wfv1.NodeStatus, boundaryID string, tmplCtx *templateresolution.Context, prefix string) bool {
+	fulfilled := true
+	for event, hook := range hooks {
+		phase := event.Phase()
+		if phase == "" {
+			continue
+		}
+		hookNodeName := common.GenerateLifecycleHookNodeName(node.Name, string(event))
+		hookNode := woc.wf.GetNodeByName(hookNodeName)
+		if hookNode == nil && (node.Phase != phase || !woc.GetShutdownStrategy().ShouldExecute(false)) {
+			continue
+		}
+		if hookNode != nil && hookNode.Fulfilled() {
+			continue
+		}
+		logCtx := woc.log.WithField("node", node.Name).WithField("lifeCycleHook", event)
+		started := hookNode != nil
+		if !started {
+			logCtx.Info("Running lifecycle hook")
+		}
+		resolvedArgs := hook.WithArgs(woc.execWf.Spec.Arguments).Arguments
+		var err error
+		if !resolvedArgs.IsEmpty() && node.Outputs != nil {
+			resolvedArgs, err = woc.resolveExitTmplArgument(resolvedArgs, prefix, node.Outputs)
+			if err != nil {
+				logCtx.WithError(err).Error("Unable to resolve lifecycle hook arguments")
+				fulfilled = false
+				continue
+			}
+		}
+		hookNode, err = woc.executeTemplate(ctx, hookNodeName, &wfv1.WorkflowStep{Template: hook.Template}, tmplCtx, resolvedArgs, &executeTemplateOpts{boundaryID: boundaryID})
+		if err != nil && err != ErrParallelismReached {
+			logCtx.WithError(err).Error("Unable to run lifecycle hook")
+		}
+		if hookNode == nil {
+			fulfilled = false
+			continue
+		}
+		if !started {
+			// a Retry node's children are its attempts, so we attach the hook to the current attempt instead
+			parentNodeName := node.Name
+			if node.Type == wfv1.NodeTypeRetry {
+				if lastChildNode := getChildNodeIndex(node, woc.wf.Status.Nodes, -1); lastChildNode != nil {
+					parentNodeName = lastChildNode.Name
+				}
+			}
+			woc.addChildNode(parentNodeName, hookNodeName)
+		}
+		if !hookNode.Fulfilled() {
+			fulfilled = false
+		}
+	}
+	return fulfilled
+}
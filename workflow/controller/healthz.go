@@ -55,3 +55,25 @@ func (wfc *WorkflowController) Healthz(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte("ok"))
 	}
 }
+
+// https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/#define-readiness-probes
+// We are ready once our informers have synced, and as long as the last config map update was applied.
+func (wfc *WorkflowController) Readyz(w http.ResponseWriter, r *http.Request) {
+	err := func() error {
+		if synced, _ := wfc.cachesSynced.Load().(bool); !synced {
+			return fmt.Errorf("informers not synced")
+		}
+		if configError, _ := wfc.configError.Load().(string); configError != "" {
+			return fmt.Errorf("config map update failed: %s", configError)
+		}
+		return nil
+	}()
+	log.WithField("err", err).Debug("readyz")
+	if err != nil {
+		w.WriteHeader(500)
+		_, _ = w.Write([]byte(err.Error()))
+	} else {
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte("ok"))
+	}
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestReadyz(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	readyz := func() (int, string) {
+		w := httptest.NewRecorder()
+		controller.Readyz(w, httptest.NewRequest("GET", "/readyz", nil))
+		return w.Code, w.Body.String()
+	}
+	t.Run("NotSynced", func(t *testing.T) {
+		controller := &WorkflowController{}
+		w := httptest.NewRecorder()
+		controller.Readyz(w, httptest.NewRequest("GET", "/readyz", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "informers not synced", w.Body.String())
+	})
+	t.Run("Ready", func(t *testing.T) {
+		code, body := readyz()
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", body)
+	})
+	t.Run("ConfigUpdateFailed", func(t *testing.T) {
+		_ = controller.onConfigChange(&config.Config{})
+		code, body := readyz()
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, "config map update failed: ConfigMap does not have executorImage", body)
+	})
+	t.Run("ConfigUpdated", func(t *testing.T) {
+		_ = controller.onConfigChange(&config.Config{ExecutorImage: "executor:latest"})
+		code, _ := readyz()
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
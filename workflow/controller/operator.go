@@ -235,6 +235,8 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 	}
 
+	woc.addArtifactGCFinalizer()
+
 	// Update workflow duration variable
 	woc.globalParams[common.GlobalVarWorkflowDuration] = fmt.Sprintf("%f", time.Since(woc.wf.Status.StartedAt.Time).Seconds())
 
@@ -279,6 +281,16 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 			// TODO: we need to re-add to the workqueue, but should happen in caller
 			return
 		}
+		// each pod is given the storage remaining when it was created, so pods running in parallel may together
+		// upload more than the limit
+		if limit := woc.getArtifactStorageLimit(); limit != nil {
+			if uploaded := woc.getArtifactBytesUploaded(); uploaded > *limit {
+				message := fmt.Sprintf("artifact storage limit of %d bytes exceeded: %d bytes uploaded", *limit, uploaded)
+				woc.failUnfulfilledNodes(message)
+				woc.markWorkflowError(ctx, errors.New(errors.CodeForbidden, message))
+				return
+			}
+		}
 	}
 
 	if woc.ShouldSuspend() {
@@ -1085,14 +1097,19 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		newPhase = wfv1.NodeSucceeded
 		newDaemonStatus = pointer.BoolPtr(false)
 	case apiv1.PodFailed:
-		// ignore pod failure for daemoned steps
-		if node.IsDaemoned() {
-			newPhase = wfv1.NodeSucceeded
-		} else {
-			newPhase, message = woc.inferFailedReason(pod)
-			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
-				WithField("pod", pod.Name).Infof("Pod failed: %s", message)
+		newPhase, message = woc.inferFailedReason(pod)
+		if executorErr, ok := pod.Annotations[common.AnnotationKeyExecutorError]; ok && newPhase == wfv1.NodeFailed {
+			// the user's code failed, but so did the executor, e.g. saving the outputs, which must not go unnoticed
+			message = fmt.Sprintf("%s (executor error: %s)", message, executorErr)
+		}
+		if node.IsDaemoned() && newPhase != wfv1.NodeSucceeded {
+			// the controller marks a daemoned node succeeded before it stops the pod at the end of the node's
+			// scope, so a pod which fails while its node is still daemoned has crashed
+			node.DaemonCrashed = true
+			updated = true
 		}
+		woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
+			WithField("pod", pod.Name).Infof("Pod failed: %s", message)
 		newDaemonStatus = pointer.BoolPtr(false)
 	case apiv1.PodRunning:
 		newPhase = wfv1.NodeRunning
@@ -1166,6 +1183,13 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	// we only need to update these values if the container transitions to complete
 	if !node.Phase.Fulfilled() && newPhase.Fulfilled() {
 		// outputs are mixed between the annotation (parameters, artifacts, and result) and the pod's status (exit code)
+		if v, ok := pod.Annotations[common.AnnotationKeyArtifactBytesUploaded]; ok {
+			if n, err := strconv.ParseInt(v, 10, 64); err != nil {
+				woc.log.WithError(err).Warnf("Unable to parse artifact bytes uploaded by node %s: %s", node.ID, v)
+			} else {
+				node.ArtifactBytesUploaded = n
+			}
+		}
 		if exitCode := getExitCode(pod); exitCode != nil {
 			woc.log.Infof("Updating node %s exit code %d", node.ID, *exitCode)
 			node.Outputs = &wfv1.Outputs{ExitCode: pointer.StringPtr(fmt.Sprintf("%d", int(*exitCode)))}
@@ -1179,6 +1203,12 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		}
 	}
 
+	if imageID := getMainContainerImageID(pod); imageID != "" && node.ImageID != imageID {
+		woc.log.Infof("Updating node %s image ID %s", node.ID, imageID)
+		updated = true
+		node.ImageID = imageID
+	}
+
 	if node.Phase != newPhase {
 		woc.log.Infof("Updating node %s status %s -> %s", node.ID, node.Phase, newPhase)
 		// if we are transitioning from Pending to a different state, clear out pending message
@@ -1214,6 +1244,16 @@ func getExitCode(pod *apiv1.Pod) *int32 {
 	return nil
 }
 
+// getMainContainerImageID returns the resolved image ID of the main container, once it has started
+func getMainContainerImageID(pod *apiv1.Pod) string {
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.Name == common.MainContainerName && (c.State.Running != nil || c.State.Terminated != nil) {
+			return c.ImageID
+		}
+	}
+	return ""
+}
+
 func podHasContainerNeedingTermination(pod *apiv1.Pod, tmpl wfv1.Template) bool {
 	for _, c := range pod.Status.ContainerStatuses {
 		// Only clean up pod when both the wait and the main containers are terminated
@@ -1325,11 +1365,13 @@ func (woc *wfOperationCtx) inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, st
 		}
 
 		switch {
-		case ctr.Name == common.InitContainerName:
-			return wfv1.NodeError, msg
 		case tmpl.IsMainContainerName(ctr.Name):
 			return wfv1.NodeFailed, msg
-		case ctr.Name == common.WaitContainerName:
+		case ctr.Name == common.InitContainerName, ctr.Name == common.WaitContainerName:
+			// the executor failed, rather than the user's code
+			if executorErr, ok := pod.Annotations[common.AnnotationKeyExecutorError]; ok {
+				return wfv1.NodeError, fmt.Sprintf("executor error: %s", executorErr)
+			}
 			return wfv1.NodeError, msg
 		default:
 			if t.ExitCode == 137 || t.ExitCode == 143 {
@@ -1495,7 +1537,7 @@ func buildRetryStrategyLocalScope(node *wfv1.NodeStatus, nodes wfv1.Nodes) map[s
 	lastChildNode := getChildNodeIndex(node, nodes, -1)
 
 	exitCode := "-1"
-	if lastChildNode.Outputs != nil {
+	if lastChildNode.Outputs != nil && lastChildNode.Outputs.ExitCode != nil {
 		exitCode = *lastChildNode.Outputs.ExitCode
 	}
 	localScope[common.LocalVarRetriesLastExitCode] = exitCode
@@ -1974,6 +2016,28 @@ func (woc *wfOperationCtx) markWorkflowError(ctx context.Context, err error) {
 	woc.markWorkflowPhase(ctx, wfv1.WorkflowError, err.Error())
 }
 
+// getArtifactStorageLimit returns the maximum number of bytes of output artifacts the workflow may upload, or nil if
+// it is not limited
+func (woc *wfOperationCtx) getArtifactStorageLimit() *int64 {
+	limit := woc.controller.Config.ArtifactStorageLimit
+	if woc.execWf.Spec.ArtifactStorageLimit != nil {
+		limit = woc.execWf.Spec.ArtifactStorageLimit
+	}
+	if limit == nil {
+		return nil
+	}
+	return pointer.Int64Ptr(limit.Value())
+}
+
+// getArtifactBytesUploaded returns the total size of the output artifacts uploaded by the workflow's nodes so far
+func (woc *wfOperationCtx) getArtifactBytesUploaded() int64 {
+	var uploaded int64
+	for _, node := range woc.wf.Status.Nodes {
+		uploaded += node.ArtifactBytesUploaded
+	}
+	return uploaded
+}
+
 // stepsOrDagSeparator identifies if a node name starts with our naming convention separator from
 // DAG or steps templates. Will match stings with prefix like: [0]. or .
 var stepsOrDagSeparator = regexp.MustCompile(`^(\[\d+\])?\.`)
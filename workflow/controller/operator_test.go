@@ -1215,11 +1215,12 @@ func TestAssessNodeStatus(t *testing.T) {
 		name: "pod failed - daemoned",
 		pod: &apiv1.Pod{
 			Status: apiv1.PodStatus{
-				Phase: apiv1.PodFailed,
+				Message: "failed for some reason",
+				Phase:   apiv1.PodFailed,
 			},
 		},
 		node: &wfv1.NodeStatus{Daemoned: &daemoned},
-		want: wfv1.NodeSucceeded,
+		want: wfv1.NodeFailed,
 	}, {
 		name: "pod failed - not daemoned",
 		pod: &apiv1.Pod{
@@ -1262,6 +1263,137 @@ func TestAssessNodeStatus(t *testing.T) {
 	}
 }
 
+func TestAssessNodeStatusDaemonCrashed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	pod := &apiv1.Pod{Status: apiv1.PodStatus{Phase: apiv1.PodFailed, Message: "OOMKilled"}}
+	node := woc.assessNodeStatus(pod, &wfv1.NodeStatus{Phase: wfv1.NodeRunning, Daemoned: pointer.BoolPtr(true)})
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "OOMKilled", node.Message)
+		assert.True(t, node.DaemonCrashed)
+		assert.False(t, node.IsDaemoned())
+	}
+}
+
+func TestAssessNodeStatusImageID(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	t.Run("NotStarted", func(t *testing.T) {
+		pod := &apiv1.Pod{
+			Status: apiv1.PodStatus{
+				Phase: apiv1.PodPending,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{Name: common.MainContainerName, State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{}}},
+				},
+			},
+		}
+		node := woc.assessNodeStatus(pod, &wfv1.NodeStatus{})
+		if assert.NotNil(t, node) {
+			assert.Empty(t, node.ImageID)
+		}
+	})
+	t.Run("Running", func(t *testing.T) {
+		pod := &apiv1.Pod{
+			Status: apiv1.PodStatus{
+				Phase: apiv1.PodRunning,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{Name: common.WaitContainerName, ImageID: "docker-pullable://argoproj/argoexec@sha256:0000", State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+					{Name: common.MainContainerName, ImageID: "docker-pullable://docker/whalesay@sha256:1234", State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+				},
+			},
+		}
+		node := woc.assessNodeStatus(pod, &wfv1.NodeStatus{})
+		if assert.NotNil(t, node) {
+			assert.Equal(t, "docker-pullable://docker/whalesay@sha256:1234", node.ImageID)
+		}
+	})
+}
+
+var artifactStorageLimitWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-storage-limit
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: pod
+          - name: b
+            template: pod
+          - name: c
+            template: pod
+        - - name: d
+            template: pod
+    - name: pod
+      container:
+        image: my-image
+`
+
+func TestArtifactStorageLimit(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactStorageLimitWf)
+	limit := resource.MustParse("1Ki")
+	cancel, controller := newController(wf, func(controller *WorkflowController) {
+		controller.Config.ArtifactStorageLimit = &limit
+	})
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	// the parallel pods are all given the whole limit
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 3) {
+		for _, pod := range pods.Items {
+			assert.Contains(t, pod.Spec.Containers[0].Env, apiv1.EnvVar{Name: common.EnvVarArtifactStorageRemaining, Value: "1024"})
+		}
+	}
+
+	// a and b each upload less than the limit, but more together, while c is still running
+	nodeC := woc.wf.GetNodeByName("artifact-storage-limit[0].c")
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, func(pod *apiv1.Pod) {
+		if pod.Name == nodeC.ID {
+			pod.Status.Phase = apiv1.PodRunning
+		} else {
+			pod.Annotations[common.AnnotationKeyArtifactBytesUploaded] = "600"
+		}
+	})
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	message := "artifact storage limit of 1024 bytes exceeded: 1200 bytes uploaded"
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	assert.Equal(t, message, woc.wf.Status.Message)
+	for _, node := range woc.wf.Status.Nodes {
+		assert.True(t, node.Fulfilled(), node.Name)
+	}
+	if node := woc.wf.GetNodeByName("artifact-storage-limit[0].c"); assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, message, node.Message)
+	}
+	// the running pod is stopped, and no more pods are created
+	// the completed pods are labelled too
+	assert.Eventually(t, func() bool { return controller.podCleanupQueue.Len() == 3 }, time.Second, 10*time.Millisecond)
+	var keys []interface{}
+	for controller.podCleanupQueue.Len() > 0 {
+		key, _ := controller.podCleanupQueue.Get()
+		keys = append(keys, key)
+	}
+	assert.Contains(t, keys, newPodCleanupKey(woc.wf.Namespace, nodeC.ID, shutdownPod))
+	pods, err = listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 3)
+	}
+}
+
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
 	tmpl := &wfv1.Template{}
 	for _, c := range pod.Spec.Containers {
@@ -4999,7 +5131,7 @@ status:
       name: my-wf
       phase: Failed
 `)
-	wf, err := util.FormulateResubmitWorkflow(wf, true)
+	wf, err := util.FormulateResubmitWorkflow(wf, true, nil)
 	if assert.NoError(t, err) {
 		cancel, controller := newController(wf)
 		defer cancel()
@@ -5683,6 +5815,33 @@ func TestPodFailureWithContainerOOM(t *testing.T) {
 	}
 }
 
+func TestPodFailureWithExecutorError(t *testing.T) {
+	t.Run("ExecutorFailed", func(t *testing.T) {
+		var pod apiv1.Pod
+		wfv1.MustUnmarshal(podWithWaitContainerOOM, &pod)
+		pod.Annotations = map[string]string{common.AnnotationKeyExecutorError: "failed to save outputs: key unsupported"}
+		nodeStatus, msg := newWoc().inferFailedReason(&pod)
+		assert.Equal(t, wfv1.NodeError, nodeStatus)
+		assert.Equal(t, "executor error: failed to save outputs: key unsupported", msg)
+	})
+	t.Run("MainFailed", func(t *testing.T) {
+		var pod apiv1.Pod
+		wfv1.MustUnmarshal(podWithMainContainerOOM, &pod)
+		pod.Annotations = map[string]string{common.AnnotationKeyExecutorError: "failed to save outputs: key unsupported"}
+		nodeStatus, msg := newWoc().inferFailedReason(&pod)
+		assert.Equal(t, wfv1.NodeFailed, nodeStatus)
+		assert.Contains(t, msg, "OOMKilled")
+		// the executor error is still recorded on the node
+		pod.Status.Phase = apiv1.PodFailed
+		node := newWoc().assessNodeStatus(&pod, &wfv1.NodeStatus{})
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Contains(t, node.Message, "OOMKilled")
+			assert.Contains(t, node.Message, "(executor error: failed to save outputs: key unsupported)")
+		}
+	})
+}
+
 func TestResubmitPendingPods(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 apiVersion: argoproj.io/v1alpha1
@@ -7435,3 +7594,15 @@ func TestBuildRetryStrategyLocalScope(t *testing.T) {
 	assert.Equal(t, string(wfv1.NodeFailed), localScope[common.LocalVarRetriesLastStatus])
 	assert.Equal(t, "6", localScope[common.LocalVarRetriesLastDuration])
 }
+
+func TestBuildRetryStrategyLocalScopeWithoutExitCode(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(operatorRetryExpression)
+	retryNode := wf.GetNodeByName("retry-script-9z9pv[1].retry")
+	lastChildNode := getChildNodeIndex(retryNode, wf.Status.Nodes, -1)
+	lastChildNode.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "p", Value: wfv1.AnyStringPtr("v")}}}
+	wf.Status.Nodes[lastChildNode.ID] = *lastChildNode
+
+	localScope := buildRetryStrategyLocalScope(retryNode, wf.Status.Nodes)
+
+	assert.Equal(t, "-1", localScope[common.LocalVarRetriesLastExitCode])
+}
@@ -113,6 +113,8 @@ func (s *wfScope) resolveArtifact(art *wfv1.Artifact) (*wfv1.Artifact, error) {
 
 		// Copy resolved artifact pointer before adding subpath
 		copyArt := valArt.DeepCopy()
+		// the checksum is of the whole artifact, not the subpath
+		copyArt.Checksum = ""
 		return copyArt, copyArt.AppendToKey(resolvedSubPath)
 	}
 
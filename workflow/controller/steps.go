@@ -103,6 +103,17 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 			return woc.markNodePhase(nodeName, wfv1.NodeFailed, sgNode.Message), nil
 		}
 
+		// A daemon which crashed after its step group completed fails the steps, as the steps which follow may rely on
+		// it. This is only checked before the next step group is started, so that running steps are not abandoned.
+		if woc.wf.GetNodeByName(fmt.Sprintf("%s[%d]", nodeName, i+1)) == nil {
+			if crashed := woc.getCrashedDaemonedChild(stepsCtx.boundaryID); crashed != nil {
+				failMessage := fmt.Sprintf("daemoned child '%s' crashed", crashed.Name)
+				woc.log.Info(failMessage)
+				woc.updateOutboundNodes(nodeName, tmpl)
+				return woc.markNodePhase(nodeName, wfv1.NodeFailed, failMessage), nil
+			}
+		}
+
 		// Add all outputs of each step in the group to the scope
 		for _, step := range stepGroup.Steps {
 			childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
@@ -257,6 +268,9 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	for _, childNodeID := range node.Children {
 		childNode := woc.wf.Status.Nodes[childNodeID]
 		step := nodeSteps[childNode.Name]
+		if !woc.runLifecycleHooks(ctx, step.Hooks, &childNode, stepsCtx.boundaryID, stepsCtx.tmplCtx, "steps."+step.Name) {
+			completed = false
+		}
 		if !childNode.Fulfilled() {
 			completed = false
 		} else if childNode.Completed() {
@@ -2,9 +2,12 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -296,3 +299,198 @@ func TestOptionalArgumentAndParameter(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
 }
+
+var stepsFilteringOnOutputParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: fan-in
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: gen
+            template: gen
+            arguments:
+              parameters:
+                - name: region
+                  value: "{{item}}"
+            withItems: [us, eu, us]
+        - - name: consume
+            template: consume
+            withParam: "{{steps.gen.outputs.parameters}}"
+            when: "{{item.region}} == us"
+    - name: gen
+      inputs:
+        parameters:
+          - name: region
+      container:
+        image: my-image
+      outputs:
+        parameters:
+          - name: region
+            valueFrom:
+              path: /tmp/region
+    - name: consume
+      container:
+        image: my-image
+`
+
+// children are selected by the values of their output parameters
+func TestStepsFilteringOnOutputParameters(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsFilteringOnOutputParameters)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), func(pod *apiv1.Pod) {
+		region := "eu"
+		if strings.HasSuffix(pod.Annotations[common.AnnotationKeyNodeName], ":us)") {
+			region = "us"
+		}
+		pod.Annotations[common.AnnotationKeyOutputs] = fmt.Sprintf(`{"parameters":[{"name":"region","value":%q}]}`, region)
+	})
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	consumed := 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.TemplateName == "consume" {
+			if strings.Contains(node.Name, "region:us") {
+				assert.Equal(t, wfv1.NodePending, node.Phase)
+				consumed++
+			} else {
+				assert.Equal(t, wfv1.NodeSkipped, node.Phase)
+			}
+		}
+	}
+	assert.Equal(t, 2, consumed)
+}
+
+const stepsWithLifecycleHooks = `
+metadata:
+  name: hooks
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: whalesay
+            hooks:
+              running:
+                template: notify
+              failed:
+                template: notify
+    - name: whalesay
+      container:
+        image: docker/whalesay
+    - name: notify
+      container:
+        image: alpine
+`
+
+func TestStepsWithLifecycleHooks(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsWithLifecycleHooks)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.GetNodeByName("hooks[0].a.hooks.running"))
+
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	if hookNode := woc.wf.GetNodeByName("hooks[0].a.hooks.running"); assert.NotNil(t, hookNode) {
+		assert.True(t, woc.wf.GetNodeByName("hooks[0].a").HasChild(hookNode.ID))
+	}
+	assert.Nil(t, woc.wf.GetNodeByName("hooks[0].a.hooks.failed"))
+
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("hooks[0].a").Phase)
+	assert.NotNil(t, woc.wf.GetNodeByName("hooks[0].a.hooks.failed"))
+	// the step group waits for the failed hook to complete
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+}
+
+const stepsWithCrashedDaemon = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: daemon
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: server
+            template: server
+        - - name: client
+            template: client
+        - - name: after
+            template: client
+    - name: server
+      daemon: true
+      container:
+        image: my-image
+    - name: client
+      container:
+        image: my-image
+`
+
+func makeNodePodPhase(ctx context.Context, t *testing.T, woc *wfOperationCtx, nodeName string, phase apiv1.PodPhase) {
+	pod, err := getPod(woc, woc.wf.GetNodeByName(nodeName).ID)
+	if assert.NoError(t, err) {
+		pod.Status.Phase = phase
+		if phase == apiv1.PodFailed {
+			pod.Status.Message = "Pod failed"
+		}
+		pod, err = woc.controller.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
+		if assert.NoError(t, err) {
+			assert.NoError(t, woc.controller.podInformer.GetStore().Update(pod))
+		}
+	}
+}
+
+func TestStepsWithCrashedDaemon(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsWithCrashedDaemon)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.True(t, woc.wf.GetNodeByName("daemon[0].server").IsDaemoned())
+	assert.NotNil(t, woc.wf.GetNodeByName("daemon[1].client"))
+
+	makeNodePodPhase(ctx, t, woc, "daemon[0].server", apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	if node := woc.wf.GetNodeByName("daemon[0].server"); assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.True(t, node.DaemonCrashed)
+	}
+	// the running step is not abandoned
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("daemon").Phase)
+
+	makeNodePodPhase(ctx, t, woc, "daemon[1].client", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	if node := woc.wf.GetNodeByName("daemon"); assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "daemoned child 'daemon[0].server' crashed", node.Message)
+	}
+	assert.Nil(t, woc.wf.GetNodeByName("daemon[2].after"))
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
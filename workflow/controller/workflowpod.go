@@ -303,6 +303,13 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		{Name: common.EnvVarIncludeScriptOutput, Value: strconv.FormatBool(opts.includeScriptOutput)},
 		{Name: common.EnvVarDeadline, Value: woc.getDeadline(opts).Format(time.RFC3339)},
 	}
+	if limit := woc.getArtifactStorageLimit(); limit != nil {
+		remaining := *limit - woc.getArtifactBytesUploaded()
+		if remaining < 0 {
+			remaining = 0
+		}
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarArtifactStorageRemaining, Value: strconv.FormatInt(remaining, 10)})
+	}
 
 	for i, c := range pod.Spec.InitContainers {
 		c.Env = append(c.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: c.Name})
@@ -466,13 +473,13 @@ func substitutePodParams(pod *apiv1.Pod, globalParams common.Parameters, tmpl *w
 
 func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container {
 	ctr := woc.newExecContainer(common.InitContainerName, tmpl)
-	ctr.Command = []string{"argoexec", "init", "--loglevel", getExecutorLogLevel()}
+	ctr.Command = []string{"argoexec", "init", "--loglevel", woc.getExecutorLogLevel()}
 	return *ctr
 }
 
 func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) *apiv1.Container {
 	ctr := woc.newExecContainer(common.WaitContainerName, tmpl)
-	ctr.Command = []string{"argoexec", "wait", "--loglevel", getExecutorLogLevel()}
+	ctr.Command = []string{"argoexec", "wait", "--loglevel", woc.getExecutorLogLevel()}
 	switch woc.getContainerRuntimeExecutor() {
 	case common.ContainerRuntimeExecutorPNS:
 		ctr.SecurityContext = &apiv1.SecurityContext{
@@ -497,7 +504,10 @@ func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) *apiv1.Containe
 	return ctr
 }
 
-func getExecutorLogLevel() string {
+func (woc *wfOperationCtx) getExecutorLogLevel() string {
+	if woc.controller.Config.ExecutorLogLevel != "" {
+		return woc.controller.Config.ExecutorLogLevel
+	}
 	return log.GetLevel().String()
 }
 
@@ -573,21 +583,7 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 }
 
 func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
-	var volumes []apiv1.Volume
-	if woc.controller.Config.KubeConfig != nil {
-		name := woc.controller.Config.KubeConfig.VolumeName
-		if name == "" {
-			name = common.KubeConfigDefaultVolumeName
-		}
-		volumes = append(volumes, apiv1.Volume{
-			Name: name,
-			VolumeSource: apiv1.VolumeSource{
-				Secret: &apiv1.SecretVolumeSource{
-					SecretName: woc.controller.Config.KubeConfig.SecretName,
-				},
-			},
-		})
-	}
+	volumes := woc.kubeConfigVolumes()
 	switch woc.getContainerRuntimeExecutor() {
 	case common.ContainerRuntimeExecutorKubelet, common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorPNS:
 	case common.ContainerRuntimeExecutorEmissary:
@@ -599,6 +595,25 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 	return volumes
 }
 
+// kubeConfigVolumes returns the volume of the kubeconfig the executor uses, if one is configured
+func (woc *wfOperationCtx) kubeConfigVolumes() []apiv1.Volume {
+	if woc.controller.Config.KubeConfig == nil {
+		return nil
+	}
+	name := woc.controller.Config.KubeConfig.VolumeName
+	if name == "" {
+		name = common.KubeConfigDefaultVolumeName
+	}
+	return []apiv1.Volume{{
+		Name: name,
+		VolumeSource: apiv1.VolumeSource{
+			Secret: &apiv1.SecretVolumeSource{
+				SecretName: woc.controller.Config.KubeConfig.SecretName,
+			},
+		},
+	}}
+}
+
 func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *apiv1.Container {
 	exec := apiv1.Container{
 		Name:            name,
@@ -1146,6 +1161,8 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 		createSecretVal(volMap, ossRepo.SecretKeySecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
 	}
 }
 
@@ -1160,6 +1177,12 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 	} else if art.Artifactory != nil {
 		createSecretVal(volMap, art.Artifactory.UsernameSecret, keyMap)
 		createSecretVal(volMap, art.Artifactory.PasswordSecret, keyMap)
+	} else if art.HTTP != nil && art.HTTP.Auth != nil {
+		if basicAuth := art.HTTP.Auth.BasicAuth; basicAuth != nil {
+			createSecretVal(volMap, basicAuth.UsernameSecret, keyMap)
+			createSecretVal(volMap, basicAuth.PasswordSecret, keyMap)
+		}
+		createSecretVal(volMap, art.HTTP.Auth.BearerTokenSecret, keyMap)
 	} else if art.HDFS != nil {
 		createSecretVal(volMap, art.HDFS.KrbCCacheSecret, keyMap)
 		createSecretVal(volMap, art.HDFS.KrbKeytabSecret, keyMap)
@@ -1168,6 +1191,8 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
 	}
 }
 
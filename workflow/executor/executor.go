@@ -6,6 +6,8 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,6 +17,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -69,7 +72,11 @@ type WorkflowExecutor struct {
 	RESTClient          rest.Interface
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
+	// ArtifactStorageRemaining is the number of bytes of artifacts the workflow may still upload, nil if unlimited
+	ArtifactStorageRemaining *int64
 
+	// number of bytes of artifacts uploaded by this pod
+	artifactBytesUploaded int64
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
 	// memoized secrets
@@ -128,14 +135,32 @@ func NewExecutor(clientset kubernetes.Interface, restClient rest.Interface, podN
 func (we *WorkflowExecutor) HandleError(ctx context.Context) {
 	if r := recover(); r != nil {
 		util.WriteTeriminateMessage(fmt.Sprintf("%v", r))
+		if err := we.AddAnnotation(ctx, common.AnnotationKeyExecutorError, fmt.Sprintf("executor panic: %v", r)); err != nil {
+			log.WithError(err).Warn("Failed to annotate pod with executor error")
+		}
 		log.Fatalf("executor panic: %+v\n%s", r, debug.Stack())
 	} else {
 		if len(we.errors) > 0 {
 			util.WriteTeriminateMessage(we.errors[0].Error())
+			if err := we.AddAnnotation(ctx, common.AnnotationKeyExecutorError, we.errorSummary()); err != nil {
+				log.WithError(err).Warn("Failed to annotate pod with executor error")
+			}
 		}
 	}
 }
 
+// errorSummary returns a concise description of the errors encountered during execution
+func (we *WorkflowExecutor) errorSummary() string {
+	summary := we.errors[0].Error()
+	switch n := len(we.errors) - 1; {
+	case n == 1:
+		summary += " (and 1 more error)"
+	case n > 1:
+		summary = fmt.Sprintf("%s (and %d more errors)", summary, n)
+	}
+	return summary
+}
+
 // LoadArtifacts loads artifacts from location to a container path
 func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 	log.Infof("Start loading input artifacts...")
@@ -189,6 +214,10 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
+		err = verifyChecksum(art, tempArtPath)
+		if err != nil {
+			return err
+		}
 
 		isTar := false
 		isZip := false
@@ -318,10 +347,28 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	fileInfo, err := os.Stat(localArtPath)
+	if err != nil {
+		return err
+	}
+	size, err := artifactSize(localArtPath)
+	if err != nil {
+		return err
+	}
+	if fileInfo.Mode().IsRegular() {
+		art.Checksum, err = checksum(localArtPath)
+		if err != nil {
+			return err
+		}
+	}
+	if we.ArtifactStorageRemaining != nil && we.artifactBytesUploaded+size > *we.ArtifactStorageRemaining {
+		return errors.Errorf(errors.CodeForbidden, "saving %s (%d bytes) would exceed the workflow's artifact storage limit: %d bytes remaining", art.Name, size, *we.ArtifactStorageRemaining-we.artifactBytesUploaded)
+	}
 	err = artDriver.Save(localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	we.artifactBytesUploaded += size
 	we.maybeDeleteLocalArtPath(localArtPath)
 	log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
@@ -717,6 +764,13 @@ func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArt *wfv1.Ar
 		outputs.Artifacts = append(outputs.Artifacts, *logArt)
 	}
 
+	if we.artifactBytesUploaded > 0 {
+		err := we.AddAnnotation(ctx, common.AnnotationKeyArtifactBytesUploaded, strconv.FormatInt(we.artifactBytesUploaded, 10))
+		if err != nil {
+			return err
+		}
+	}
+
 	if !outputs.HasOutputs() {
 		return nil
 	}
@@ -747,6 +801,52 @@ func (we *WorkflowExecutor) AddAnnotation(ctx context.Context, key, value string
 	return common.AddPodAnnotation(ctx, we.ClientSet, we.PodName, we.Namespace, key, value, ExecutorRetry)
 }
 
+// artifactSize returns the number of bytes of the file, or of all the files in the directory, at the path
+func artifactSize(filePath string) (int64, error) {
+	var size int64
+	err := filepath.Walk(filePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// checksum returns the SHA256 checksum of a file, as "sha256:" followed by the hex encoded digest
+func checksum(filePath string) (string, error) {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyChecksum returns an error if the artifact has a checksum, i.e. it was saved as a single file, which does not
+// match the checksum of the loaded file
+func verifyChecksum(art wfv1.Artifact, filePath string) error {
+	if art.Checksum == "" {
+		return nil
+	}
+	sum, err := checksum(filePath)
+	if err != nil {
+		return err
+	}
+	if sum != art.Checksum {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s failed checksum verification, it may be corrupted: expected %s, got %s", art.Name, art.Checksum, sum)
+	}
+	log.Infof("Verified checksum of artifact %s: %s", art.Name, sum)
+	return nil
+}
+
 // isTarball returns whether or not the file is a tarball
 func isTarball(filePath string) (bool, error) {
 	log.Infof("Detecting if %s is a tarball", filePath)
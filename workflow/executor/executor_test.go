@@ -3,8 +3,12 @@ package executor
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -235,6 +239,94 @@ func TestIsTarball(t *testing.T) {
 	}
 }
 
+func TestChecksum(t *testing.T) {
+	sum, err := checksum("testdata/file")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", sum)
+	}
+	_, err = checksum("testdata/not-found")
+	assert.Error(t, err)
+}
+
+func TestSaveArtifactChecksum(t *testing.T) {
+	// a blob service which accepts any upload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	newArtifact := func() *wfv1.Artifact {
+		return &wfv1.Artifact{
+			Name: "my-artifact",
+			ArtifactLocation: wfv1.ArtifactLocation{
+				Azure: &wfv1.AzureArtifact{
+					AzureBlobContainer: wfv1.AzureBlobContainer{Endpoint: server.URL + "/my-account", Container: "my-container"},
+					Blob:               "my-blob",
+				},
+			},
+		}
+	}
+	ctx := context.Background()
+	we := WorkflowExecutor{}
+	t.Run("File", func(t *testing.T) {
+		art := newArtifact()
+		err := we.saveArtifactFromFile(ctx, art, "file", "testdata/file.tar")
+		if assert.NoError(t, err) {
+			sum, err := checksum("testdata/file.tar")
+			assert.NoError(t, err)
+			assert.Equal(t, sum, art.Checksum)
+		}
+	})
+	t.Run("Directory", func(t *testing.T) {
+		// a directory saved with `archive: none` is uploaded file by file, so there is no single file to checksum
+		art := newArtifact()
+		err := we.saveArtifactFromFile(ctx, art, "testdata", "testdata")
+		if assert.NoError(t, err) {
+			assert.Empty(t, art.Checksum)
+		}
+	})
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	sum, err := checksum("testdata/file.tar")
+	assert.NoError(t, err)
+	t.Run("NoChecksum", func(t *testing.T) {
+		assert.NoError(t, verifyChecksum(wfv1.Artifact{Name: "my-artifact"}, "testdata/file.tar"))
+	})
+	t.Run("Verified", func(t *testing.T) {
+		assert.NoError(t, verifyChecksum(wfv1.Artifact{Name: "my-artifact", Checksum: sum}, "testdata/file.tar"))
+	})
+	t.Run("Mismatch", func(t *testing.T) {
+		err := verifyChecksum(wfv1.Artifact{Name: "my-artifact", Checksum: sum}, "testdata/file.zip")
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "artifact my-artifact failed checksum verification, it may be corrupted: expected "+sum)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		assert.Error(t, verifyChecksum(wfv1.Artifact{Name: "my-artifact", Checksum: sum}, "testdata/not-found"))
+	})
+}
+
+func TestArtifactSize(t *testing.T) {
+	size, err := artifactSize("testdata/file.tar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1536), size)
+	}
+	dir, err := ioutil.TempDir("", "artifact-size")
+	if assert.NoError(t, err) {
+		defer func() { _ = os.RemoveAll(dir) }()
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), make([]byte, 10), 0o644))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 20), 0o644))
+		size, err = artifactSize(dir)
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(30), size)
+		}
+	}
+	_, err = artifactSize("testdata/not-found")
+	assert.Error(t, err)
+}
+
 func TestUnzip(t *testing.T) {
 	zipPath := "testdata/file.zip"
 	destPath := "testdata/unzippedFile"
@@ -365,3 +457,13 @@ func TestSaveArtifacts(t *testing.T) {
 	err = we.SaveArtifacts(ctx)
 	assert.Error(t, err)
 }
+
+func TestErrorSummary(t *testing.T) {
+	we := WorkflowExecutor{}
+	we.AddError(fmt.Errorf("failed to save outputs"))
+	assert.Equal(t, "failed to save outputs", we.errorSummary())
+	we.AddError(fmt.Errorf("failed to kill sidecars"))
+	assert.Equal(t, "failed to save outputs (and 1 more error)", we.errorSummary())
+	we.AddError(fmt.Errorf("failed to capture script result"))
+	assert.Equal(t, "failed to save outputs (and 2 more errors)", we.errorSummary())
+}
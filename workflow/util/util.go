@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers/internalinterfaces"
 	"k8s.io/client-go/kubernetes"
@@ -170,12 +171,17 @@ func IsWorkflowCompleted(wf *wfv1.Workflow) bool {
 
 // SubmitWorkflow validates and submit a single workflow and override some of the fields of the workflow
 func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClientset wfclientset.Interface, namespace string, wf *wfv1.Workflow, opts *wfv1.SubmitOpts) (*wfv1.Workflow, error) {
-	err := ApplySubmitOpts(wf, opts)
+	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(namespace))
+	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+
+	wftmplSpec, err := GetWorkflowTemplateRefSpec(wftmplGetter, cwftmplGetter, wf)
+	if err != nil {
+		return nil, err
+	}
+	err = ApplySubmitOpts(wf, wftmplSpec, opts)
 	if err != nil {
 		return nil, err
 	}
-	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(namespace))
-	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
 	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{})
 	if err != nil {
@@ -224,8 +230,29 @@ func PopulateSubmitOpts(command *cobra.Command, submitOpts *wfv1.SubmitOpts, inc
 	}
 }
 
-// Apply the Submit options into workflow object
-func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
+// GetWorkflowTemplateRefSpec returns the spec of the workflow template or cluster workflow template the workflow
+// references, or nil if it does not reference one
+func GetWorkflowTemplateRefSpec(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wf *wfv1.Workflow) (*wfv1.WorkflowSpec, error) {
+	ref := wf.Spec.WorkflowTemplateRef
+	if ref == nil {
+		return nil, nil
+	}
+	var wfSpecHolder wfv1.WorkflowSpecHolder
+	var err error
+	if ref.ClusterScope {
+		wfSpecHolder, err = cwftmplGetter.Get(ref.Name)
+	} else {
+		wfSpecHolder, err = wftmplGetter.Get(ref.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return wfSpecHolder.GetWorkflowSpec(), nil
+}
+
+// Apply the Submit options into workflow object. Parameters must be declared in spec.arguments.parameters of the
+// workflow, or of wftmplSpec, the spec of the workflow template the workflow references, if any.
+func ApplySubmitOpts(wf *wfv1.Workflow, wftmplSpec *wfv1.WorkflowSpec, opts *wfv1.SubmitOpts) error {
 	if opts == nil {
 		opts = &wfv1.SubmitOpts{}
 	}
@@ -316,6 +343,15 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 			}
 		}
 
+		// an undeclared parameter is most likely a typo
+		for _, param := range newParams {
+			declared := wf.Spec.Arguments.GetParameterByName(param.Name) != nil ||
+				wftmplSpec != nil && wftmplSpec.Arguments.GetParameterByName(param.Name) != nil
+			if !declared {
+				return fmt.Errorf("parameter %q is not declared in spec.arguments.parameters", param.Name)
+			}
+		}
+
 		for _, param := range wf.Spec.Arguments.Parameters {
 			if _, ok := passedParams[param.Name]; ok {
 				// this parameter was overridden via command line
@@ -589,7 +625,7 @@ func randString(n int) string {
 }
 
 // FormulateResubmitWorkflow formulate a new workflow from a previous workflow, optionally re-using successful nodes
-func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow, error) {
+func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool, parameters []string) (*wfv1.Workflow, error) {
 	newWF := wfv1.Workflow{}
 	newWF.TypeMeta = wf.TypeMeta
 
@@ -608,6 +644,9 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 		default:
 			return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to resubmit in memoized mode")
 		}
+		if len(parameters) > 0 {
+			return nil, errors.Errorf(errors.CodeBadRequest, "parameters cannot be overridden when resubmitting in memoized mode")
+		}
 		newWF.ObjectMeta.Name = newWF.ObjectMeta.GenerateName + randString(5)
 	}
 
@@ -621,6 +660,15 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 
 	newWF.Spec.Shutdown = ""
 
+	// override the parameters we were given, the same way that submit does
+	if len(parameters) > 0 {
+		// the stored spec includes the parameters declared by the workflow template the workflow references, if any
+		err := ApplySubmitOpts(&newWF, wf.Status.StoredWorkflowSpec, &wfv1.SubmitOpts{Parameters: parameters})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// carry over user labels and annotations from previous workflow.
 	if newWF.ObjectMeta.Labels == nil {
 		newWF.ObjectMeta.Labels = make(map[string]string)
@@ -736,6 +784,11 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	}
 	switch wf.Status.Phase {
 	case wfv1.WorkflowFailed, wfv1.WorkflowError:
+	case wfv1.WorkflowSucceeded:
+		// nodes of a succeeded workflow can be re-run, e.g. when debugging
+		if !restartSuccessful || nodeFieldSelector == "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry, unless restarting successful nodes matching a node field selector")
+		}
 	default:
 		return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
 	}
@@ -743,6 +796,9 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	if err != nil {
 		return nil, err
 	}
+	if wf.HasArtifactGCOnCompletion() {
+		return nil, errors.Errorf(errors.CodeBadRequest, "workflow cannot be retried as its artifacts were deleted on completion")
+	}
 
 	newWF := wf.DeepCopy()
 	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
@@ -770,8 +826,12 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		return nil, err
 	}
 
+	// the steps and DAGs containing the nodes being reset must run again, even if they succeeded
+	nodeIDsToResume := getNodeIDsToResume(nodeIDsToReset, wf.Status.Nodes)
+
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	deletedNodes := make(map[string]bool)
+	var deletedPods []string
 	for _, node := range wf.Status.Nodes {
 		doForceResetNode := false
 		if _, present := nodeIDsToReset[node.ID]; present {
@@ -781,9 +841,18 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		switch node.Phase {
 		case wfv1.NodeSucceeded, wfv1.NodeSkipped:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && !doForceResetNode {
-				newWF.Status.Nodes[node.ID] = node
+				if nodeIDsToResume[node.ID] {
+					newNode := node.DeepCopy()
+					newNode.Phase = wfv1.NodeRunning
+					newNode.Message = ""
+					newNode.FinishedAt = metav1.Time{}
+					newWF.Status.Nodes[newNode.ID] = *newNode
+				} else {
+					newWF.Status.Nodes[node.ID] = node
+				}
 				continue
 			}
+			deletedNodes[node.ID] = true
 		case wfv1.NodeError, wfv1.NodeFailed, wfv1.NodeOmitted:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && (node.Type == wfv1.NodeTypeDAG || node.Type == wfv1.NodeTypeStepGroup) {
 				newNode := node.DeepCopy()
@@ -807,6 +876,7 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			if err != nil && !apierr.IsNotFound(err) {
 				return nil, errors.InternalWrapError(err)
 			}
+			deletedPods = append(deletedPods, node.ID)
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -840,6 +910,13 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		}
 	}
 
+	// The pods of re-run nodes have the same names as before, so the controller would find the old pods if they were
+	// still terminating, rather than creating new ones.
+	err = waitForPodsDeleted(ctx, kubeClient, wf.ObjectMeta.Namespace, deletedPods)
+	if err != nil {
+		return nil, err
+	}
+
 	err = hydrator.Dehydrate(newWF)
 	if err != nil {
 		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
@@ -884,6 +961,60 @@ func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes w
 	return nodeIDsToReset, nil
 }
 
+// getNodeIDsToResume returns the IDs of the steps, DAGs and retries which contain the nodes being reset
+func getNodeIDsToResume(nodeIDsToReset map[string]bool, nodes wfv1.Nodes) map[string]bool {
+	parents := make(map[string][]string)
+	for _, node := range nodes {
+		for _, child := range node.Children {
+			parents[child] = append(parents[child], node.ID)
+		}
+	}
+	nodeIDsToResume := make(map[string]bool)
+	var queue []string
+	for nodeID := range nodeIDsToReset {
+		queue = append(queue, parents[nodeID]...)
+	}
+	for len(queue) > 0 {
+		nodeID := queue[0]
+		queue = queue[1:]
+		if nodeIDsToReset[nodeID] || nodeIDsToResume[nodeID] {
+			continue
+		}
+		switch nodes[nodeID].Type {
+		case wfv1.NodeTypeSteps, wfv1.NodeTypeStepGroup, wfv1.NodeTypeDAG, wfv1.NodeTypeTaskGroup, wfv1.NodeTypeRetry:
+			nodeIDsToResume[nodeID] = true
+		}
+		queue = append(queue, parents[nodeID]...)
+	}
+	return nodeIDsToResume
+}
+
+// podDeletionTimeout is how long to wait for the pods of retried nodes to be deleted
+var podDeletionTimeout = time.Minute
+
+// waitForPodsDeleted waits until none of the named pods exist
+func waitForPodsDeleted(ctx context.Context, kubeClient kubernetes.Interface, namespace string, podNames []string) error {
+	podIf := kubeClient.CoreV1().Pods(namespace)
+	for _, podName := range podNames {
+		err := wait.PollImmediate(time.Second, podDeletionTimeout, func() (bool, error) {
+			_, err := podIf.Get(ctx, podName, metav1.GetOptions{})
+			if apierr.IsNotFound(err) {
+				return true, nil
+			}
+			if err != nil && !errorsutil.IsTransientErr(err) {
+				return false, err
+			}
+			return false, nil
+		})
+		if err == wait.ErrWaitTimeout {
+			return errors.InternalErrorf("timed out waiting for pod %s to be deleted", podName)
+		} else if err != nil {
+			return errors.InternalWrapError(err)
+		}
+	}
+	return nil
+}
+
 var errSuspendedCompletedWorkflow = errors.Errorf(errors.CodeBadRequest, "cannot suspend completed workflows")
 
 // IsWorkflowSuspended returns whether or not a workflow is considered suspended
@@ -966,12 +1097,23 @@ func ReadFromUrl(url string) ([]byte, error) {
 	return body, err
 }
 
-// ReadFromFilePathsOrUrls reads the content of a single or a list of file paths and/or urls
+var manifestExt = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// ReadFromFilePathsOrUrls reads the content of a single or a list of file paths and/or urls. Directories are walked,
+// and every YAML or JSON file within them is read.
 func ReadFromFilePathsOrUrls(filePathsOrUrls ...string) ([][]byte, error) {
 	var fileContents [][]byte
 	var body []byte
 	var err error
 	for _, filePathOrUrl := range filePathsOrUrls {
+		if info, statErr := os.Stat(filePathOrUrl); statErr == nil && info.IsDir() {
+			dirContents, err := readFromDirectory(filePathOrUrl)
+			if err != nil {
+				return [][]byte{}, err
+			}
+			fileContents = append(fileContents, dirContents...)
+			continue
+		}
 		if cmdutil.IsURL(filePathOrUrl) {
 			body, err = ReadFromUrl(filePathOrUrl)
 			if err != nil {
@@ -988,7 +1130,24 @@ func ReadFromFilePathsOrUrls(filePathsOrUrls ...string) ([][]byte, error) {
 	return fileContents, err
 }
 
-// ReadManifest reads from stdin, a single file/url, or a list of files and/or urls
+// readFromDirectory reads the content of every YAML or JSON file within a directory, in lexical order
+func readFromDirectory(dir string) ([][]byte, error) {
+	var fileContents [][]byte
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !manifestExt[filepath.Ext(path)] {
+			return err
+		}
+		body, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		fileContents = append(fileContents, body)
+		return nil
+	})
+	return fileContents, err
+}
+
+// ReadManifest reads from stdin, a single file/url, or a list of files, directories and/or urls
 func ReadManifest(manifestPaths ...string) ([][]byte, error) {
 	var manifestContents [][]byte
 	var err error
@@ -12,12 +12,15 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
 )
@@ -67,7 +70,7 @@ func TestResubmitWorkflowWithOnExit(t *testing.T) {
 		Name:  onExitName,
 		Phase: wfv1.NodeSucceeded,
 	}
-	newWF, err := FormulateResubmitWorkflow(&wf, true)
+	newWF, err := FormulateResubmitWorkflow(&wf, true, nil)
 	assert.NoError(t, err)
 	newWFOnExitName := newWF.ObjectMeta.Name + ".onExit"
 	newWFOneExitID := newWF.NodeID(newWFOnExitName)
@@ -75,6 +78,30 @@ func TestResubmitWorkflowWithOnExit(t *testing.T) {
 	assert.False(t, ok)
 }
 
+// TestResubmitWorkflowWithParameters ensures we override the given parameters, and keep the others
+func TestResubmitWorkflowWithParameters(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-wf"},
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+				{Name: "a", Value: wfv1.AnyStringPtr("1")},
+				{Name: "b", Value: wfv1.AnyStringPtr("2")},
+			}},
+		},
+		Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed},
+	}
+	newWF, err := FormulateResubmitWorkflow(wf, false, []string{"b=3"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", newWF.Spec.Arguments.GetParameterByName("a").Value.String())
+		assert.Equal(t, "3", newWF.Spec.Arguments.GetParameterByName("b").Value.String())
+		assert.Equal(t, "2", wf.Spec.Arguments.GetParameterByName("b").Value.String())
+	}
+	_, err = FormulateResubmitWorkflow(wf, false, []string{"b"})
+	assert.EqualError(t, err, "expected parameter of the form: NAME=VALUE. Received: b")
+	_, err = FormulateResubmitWorkflow(wf, true, []string{"b=3"})
+	assert.EqualError(t, err, "parameters cannot be overridden when resubmitting in memoized mode")
+}
+
 // TestReadFromSingleorMultiplePath ensures we can read the content of a single file or multiple files correctly using the ReadFromFilePathsOrUrls function
 func TestReadFromSingleorMultiplePath(t *testing.T) {
 	tests := map[string]struct {
@@ -118,6 +145,26 @@ func TestReadFromSingleorMultiplePath(t *testing.T) {
 	}
 }
 
+// TestReadFromDirectory ensures we read every manifest within a directory, and ignore other files
+func TestReadFromDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Error("Could not create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "nested"), 0o777))
+	for name, content := range map[string]string{
+		"a.yaml":        "a",
+		"b.txt":         "b",
+		"nested/c.json": "c",
+	} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0o666))
+	}
+	body, err := ReadFromFilePathsOrUrls(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("c")}, body)
+}
+
 // TestReadFromSingleorMultiplePathErrorHandling ensures that an error is returned if there is any error while reading files or urls
 func TestReadFromSingleorMultiplePathErrorHandling(t *testing.T) {
 	tests := map[string]struct {
@@ -472,20 +519,20 @@ func TestGetNodeType(t *testing.T) {
 
 func TestApplySubmitOpts(t *testing.T) {
 	t.Run("Nil", func(t *testing.T) {
-		assert.NoError(t, ApplySubmitOpts(&wfv1.Workflow{}, nil))
+		assert.NoError(t, ApplySubmitOpts(&wfv1.Workflow{}, nil, nil))
 	})
 	t.Run("InvalidLabels", func(t *testing.T) {
-		assert.Error(t, ApplySubmitOpts(&wfv1.Workflow{}, &wfv1.SubmitOpts{Labels: "a"}))
+		assert.Error(t, ApplySubmitOpts(&wfv1.Workflow{}, nil, &wfv1.SubmitOpts{Labels: "a"}))
 	})
 	t.Run("Labels", func(t *testing.T) {
 		wf := &wfv1.Workflow{}
-		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{Labels: "a=1,b=1"})
+		err := ApplySubmitOpts(wf, nil, &wfv1.SubmitOpts{Labels: "a=1,b=1"})
 		assert.NoError(t, err)
 		assert.Len(t, wf.GetLabels(), 2)
 	})
 	t.Run("MergeLabels", func(t *testing.T) {
 		wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "0", "b": "0"}}}
-		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{Labels: "a=1"})
+		err := ApplySubmitOpts(wf, nil, &wfv1.SubmitOpts{Labels: "a=1"})
 		assert.NoError(t, err)
 		if assert.Len(t, wf.GetLabels(), 2) {
 			assert.Equal(t, "1", wf.GetLabels()["a"])
@@ -493,7 +540,7 @@ func TestApplySubmitOpts(t *testing.T) {
 		}
 	})
 	t.Run("InvalidParameters", func(t *testing.T) {
-		assert.Error(t, ApplySubmitOpts(&wfv1.Workflow{}, &wfv1.SubmitOpts{Parameters: []string{"a"}}))
+		assert.Error(t, ApplySubmitOpts(&wfv1.Workflow{}, nil, &wfv1.SubmitOpts{Parameters: []string{"a"}}))
 	})
 	t.Run("Parameters", func(t *testing.T) {
 		wf := &wfv1.Workflow{
@@ -503,7 +550,7 @@ func TestApplySubmitOpts(t *testing.T) {
 				},
 			},
 		}
-		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=81861780812"}})
+		err := ApplySubmitOpts(wf, nil, &wfv1.SubmitOpts{Parameters: []string{"a=81861780812"}})
 		assert.NoError(t, err)
 		parameters := wf.Spec.Arguments.Parameters
 		if assert.Len(t, parameters, 1) {
@@ -511,14 +558,49 @@ func TestApplySubmitOpts(t *testing.T) {
 			assert.Equal(t, "81861780812", parameters[0].Value.String())
 		}
 	})
+	t.Run("UndeclaredParameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("0")}},
+				},
+			},
+		}
+		err := ApplySubmitOpts(wf, nil, &wfv1.SubmitOpts{Parameters: []string{"b=1"}})
+		assert.EqualError(t, err, `parameter "b" is not declared in spec.arguments.parameters`)
+	})
+	t.Run("NoDeclaredParameters", func(t *testing.T) {
+		err := ApplySubmitOpts(&wfv1.Workflow{}, nil, &wfv1.SubmitOpts{Parameters: []string{"a=1"}})
+		assert.EqualError(t, err, `parameter "a" is not declared in spec.arguments.parameters`)
+	})
+	t.Run("WorkflowTemplateRefParameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{Spec: wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-wftmpl"}}}
+		wftmplSpec := &wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{
+				Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("0")}},
+			},
+		}
+		err := ApplySubmitOpts(wf, wftmplSpec, &wfv1.SubmitOpts{Parameters: []string{"a=1"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "1", wf.Spec.Arguments.GetParameterByName("a").Value.String())
+		}
+		err = ApplySubmitOpts(wf, wftmplSpec, &wfv1.SubmitOpts{Parameters: []string{"b=1"}})
+		assert.EqualError(t, err, `parameter "b" is not declared in spec.arguments.parameters`)
+	})
 	t.Run("ParameterFile", func(t *testing.T) {
-		wf := &wfv1.Workflow{}
+		wf := &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("0")}},
+				},
+			},
+		}
 		file, err := ioutil.TempFile("", "")
 		assert.NoError(t, err)
 		defer func() { _ = os.Remove(file.Name()) }()
 		err = ioutil.WriteFile(file.Name(), []byte(`a: 81861780812`), 0o644)
 		assert.NoError(t, err)
-		err = ApplySubmitOpts(wf, &wfv1.SubmitOpts{ParameterFile: file.Name()})
+		err = ApplySubmitOpts(wf, nil, &wfv1.SubmitOpts{ParameterFile: file.Name()})
 		assert.NoError(t, err)
 		parameters := wf.Spec.Arguments.Parameters
 		if assert.Len(t, parameters, 1) {
@@ -550,7 +632,7 @@ func TestFormulateResubmitWorkflow(t *testing.T) {
 				},
 			},
 		}
-		wf, err := FormulateResubmitWorkflow(wf, false)
+		wf, err := FormulateResubmitWorkflow(wf, false, nil)
 		if assert.NoError(t, err) {
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyControllerInstanceID)
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyClusterWorkflowTemplate)
@@ -721,7 +803,7 @@ status:
 
 func TestDeepDeleteNodes(t *testing.T) {
 	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
-	kubeClient := &kubefake.Clientset{}
+	kubeClient := kubefake.NewSimpleClientset()
 	origWf := wfv1.MustUnmarshalWorkflow(deepDeleteOfNodes)
 
 	ctx := context.Background()
@@ -735,6 +817,29 @@ func TestDeepDeleteNodes(t *testing.T) {
 	}
 }
 
+func TestRetryWorkflowArtifactGC(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	wfClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("my-ns")
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec:       wfv1.WorkflowSpec{ArtifactGC: &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCOnWorkflowCompletion}},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: map[string]wfv1.NodeStatus{
+				"my-wf": {Name: "my-wf", Phase: wfv1.NodeFailed, Outputs: &wfv1.Outputs{Artifacts: []wfv1.Artifact{
+					{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}, Key: "my-key"}}},
+				}}},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	_, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "")
+	assert.EqualError(t, err, "workflow cannot be retried as its artifacts were deleted on completion")
+}
+
 func TestRetryWorkflow(t *testing.T) {
 	kubeClient := kubefake.NewSimpleClientset()
 	wfClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("my-ns")
@@ -782,6 +887,108 @@ func TestRetryWorkflow(t *testing.T) {
 	}
 }
 
+const retryNodesWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+  labels:
+    workflows.argoproj.io/completed: "true"
+status:
+  phase: Succeeded
+  nodes:
+    my-wf:
+      id: my-wf
+      name: my-wf
+      type: DAG
+      phase: Succeeded
+      children: [my-wf-a, my-wf-d]
+      outboundNodes: [my-wf-c, my-wf-d]
+    my-wf-a:
+      id: my-wf-a
+      name: my-wf.a
+      type: Pod
+      phase: Succeeded
+      children: [my-wf-b]
+    my-wf-b:
+      id: my-wf-b
+      name: my-wf.b
+      type: Pod
+      phase: Succeeded
+      children: [my-wf-c]
+    my-wf-c:
+      id: my-wf-c
+      name: my-wf.c
+      type: Pod
+      phase: Succeeded
+    my-wf-d:
+      id: my-wf-d
+      name: my-wf.d
+      type: Pod
+      phase: Succeeded
+`
+
+func TestRetryWorkflowSucceeded(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T) (*kubefake.Clientset, v1alpha1.WorkflowInterface) {
+		kubeClient := kubefake.NewSimpleClientset()
+		for _, name := range []string{"my-wf-a", "my-wf-b", "my-wf-c", "my-wf-d"} {
+			_, err := kubeClient.CoreV1().Pods("my-ns").Create(ctx, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}, metav1.CreateOptions{})
+			assert.NoError(t, err)
+		}
+		wfClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("my-ns")
+		_, err := wfClient.Create(ctx, wfv1.MustUnmarshalWorkflow(retryNodesWorkflow), metav1.CreateOptions{})
+		assert.NoError(t, err)
+		return kubeClient, wfClient
+	}
+	t.Run("WithoutNodeFieldSelector", func(t *testing.T) {
+		kubeClient, wfClient := setup(t)
+		_, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, "my-wf", true, "")
+		assert.EqualError(t, err, "workflow must be Failed/Error to retry, unless restarting successful nodes matching a node field selector")
+	})
+	t.Run("RestartNode", func(t *testing.T) {
+		kubeClient, wfClient := setup(t)
+		wf, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, "my-wf", true, "name=my-wf.b")
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+			assert.NotContains(t, wf.Labels, common.LabelKeyCompleted)
+			// the node and its dependents are reset, and their pods deleted
+			for _, name := range []string{"my-wf-b", "my-wf-c"} {
+				assert.NotContains(t, wf.Status.Nodes, name)
+				_, err := kubeClient.CoreV1().Pods("my-ns").Get(ctx, name, metav1.GetOptions{})
+				assert.Error(t, err)
+			}
+			// the DAG containing them is run again
+			assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["my-wf"].Phase)
+			assert.Equal(t, []string{"my-wf-d"}, wf.Status.Nodes["my-wf"].OutboundNodes)
+			// unrelated nodes are untouched
+			for _, name := range []string{"my-wf-a", "my-wf-d"} {
+				assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes[name].Phase)
+				_, err := kubeClient.CoreV1().Pods("my-ns").Get(ctx, name, metav1.GetOptions{})
+				assert.NoError(t, err)
+			}
+			assert.Empty(t, wf.Status.Nodes["my-wf-a"].Children)
+		}
+	})
+	t.Run("PodNotDeleted", func(t *testing.T) {
+		defer func(timeout time.Duration) { podDeletionTimeout = timeout }(podDeletionTimeout)
+		podDeletionTimeout = time.Millisecond
+		kubeClient, wfClient := setup(t)
+		// the pod is still terminating
+		kubeClient.PrependReactor("delete", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, nil
+		})
+		_, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, "my-wf", true, "name=my-wf.c")
+		assert.EqualError(t, err, "timed out waiting for pod my-wf-c to be deleted")
+		wf, err := wfClient.Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, wf.Status.Phase)
+			assert.Contains(t, wf.Status.Nodes, "my-wf-c")
+		}
+	})
+}
+
 func TestFromUnstructuredObj(t *testing.T) {
 	un := &unstructured.Unstructured{}
 	wfv1.MustUnmarshal([]byte(`apiVersion: argoproj.io/v1alpha1
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -159,6 +160,17 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if err != nil {
 		return nil, err
 	}
+	parametersSchema := wf.Spec.ParametersSchema
+	if parametersSchema == nil && hasWorkflowTemplateRef {
+		parametersSchema = wfSpecHolder.GetWorkflowSpec().ParametersSchema
+	}
+	if parametersSchema != nil {
+		// if we are linting or validating a template, parameters may legitimately not have been supplied yet
+		err = validateParametersSchema("spec.arguments.", parametersSchema, wfArgs.Parameters, !ctx.Lint && !ctx.WorkflowTemplateValidation)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if len(wfArgs.Parameters) > 0 {
 		ctx.globalParams[common.GlobalVarWorkflowParameters] = placeholderGenerator.NextPlaceholder()
 	}
@@ -221,6 +233,11 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		}
 	}
 
+	err = validateArtifactGC("artifactGC", wf.Spec.ArtifactGC)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
 		_, err := ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: template.Name}, tmplCtx, &FakeArguments{})
@@ -384,6 +401,10 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	if err != nil {
 		return err
 	}
+	err = ctx.validateMemoizedArtifactGC(newTmpl)
+	if err != nil {
+		return err
+	}
 	err = ctx.validateBaseImageOutputs(newTmpl)
 	if err != nil {
 		return err
@@ -521,6 +542,34 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	return scope, nil
 }
 
+// validateMemoizedArtifactGC ensures that the output artifacts of a memoized template are not deleted by artifact GC, as
+// the memoized outputs would then refer to deleted artifacts
+func (ctx *templateValidationCtx) validateMemoizedArtifactGC(tmpl *wfv1.Template) error {
+	if tmpl.Memoize == nil {
+		return nil
+	}
+	var artifactGC *wfv1.ArtifactGC
+	if ctx.wf != nil {
+		artifactGC = ctx.wf.Spec.ArtifactGC
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		if art.GetArtifactGCStrategy(artifactGC) != wfv1.ArtifactGCNever {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts.%s.artifactGC cannot be used with memoize, as the memoized outputs would refer to deleted artifacts", tmpl.Name, art.Name)
+		}
+	}
+	return nil
+}
+
+// validateArtifactGC validates the artifact GC strategy, if any
+func validateArtifactGC(errPrefix string, artifactGC *wfv1.ArtifactGC) error {
+	switch artifactGC.GetStrategy() {
+	case wfv1.ArtifactGCNever, wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion:
+		return nil
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "%s.strategy unknown strategy '%s'", errPrefix, artifactGC.Strategy)
+	}
+}
+
 func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error {
 	if art.Git != nil {
 		if art.Git.Repo == "" {
@@ -686,6 +735,23 @@ func validateArguments(prefix string, arguments wfv1.Arguments) error {
 	return validateArgumentsValues(prefix, arguments)
 }
 
+// validateLifecycleHooks ensures that all hooks are for a known lifecycle event and name a template which exists
+func (ctx *templateValidationCtx) validateLifecycleHooks(prefix string, hooks wfv1.LifecycleHooks, tmplCtx *templateresolution.Context) error {
+	for event, hook := range hooks {
+		if event != wfv1.ExitLifecycleEvent && event.Phase() == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.%s is not a valid lifecycle event. One of: exit, running, succeeded, failed, error", prefix, event)
+		}
+		if hook.Template == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.%s.template is required", prefix, event)
+		}
+		_, err := ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: hook.Template}, tmplCtx, &FakeArguments{})
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.%s %s", prefix, event, err.Error())
+		}
+	}
+	return nil
+}
+
 func validateArgumentsFieldNames(prefix string, arguments wfv1.Arguments) error {
 	fieldToSlices := map[string]interface{}{
 		"parameters": arguments.Parameters,
@@ -733,6 +799,109 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments) error {
 	return nil
 }
 
+// validateParametersSchema ensures that the schema is valid and that the supplied parameters satisfy it.
+// All violations are reported together so that the caller can fix them in one go.
+func validateParametersSchema(prefix string, schema *wfv1.ParametersSchema, params []wfv1.Parameter, checkRequired bool) error {
+	values := make(map[string]string)
+	for _, param := range params {
+		if param.Value != nil {
+			values[param.Name] = param.Value.String()
+		}
+	}
+	var violations []string
+	if checkRequired {
+		for _, name := range schema.Required {
+			if _, ok := values[name]; !ok {
+				violations = append(violations, fmt.Sprintf("%s%s is required", prefix, name))
+			}
+		}
+	}
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		paramSchema := schema.Properties[name]
+		var pattern *regexp.Regexp
+		if paramSchema.Pattern != "" {
+			var err error
+			pattern, err = regexp.Compile(paramSchema.Pattern)
+			if err != nil {
+				violations = append(violations, fmt.Sprintf("spec.parametersSchema.properties.%s.pattern is invalid: %v", name, err))
+			}
+		}
+		if err := validateParameterSchemaType(paramSchema.Type); err != nil {
+			violations = append(violations, fmt.Sprintf("spec.parametersSchema.properties.%s.type %s", name, err.Error()))
+		}
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		if msg := checkParameterSchemaType(paramSchema.Type, value); msg != "" {
+			violations = append(violations, fmt.Sprintf("%s%s.value %s", prefix, name, msg))
+		}
+		if len(paramSchema.Enum) > 0 {
+			allowed := false
+			for _, enum := range paramSchema.Enum {
+				if string(enum) == value {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				violations = append(violations, fmt.Sprintf("%s%s.value %q must be one of %v", prefix, name, value, paramSchema.Enum))
+			}
+		}
+		if pattern != nil && !pattern.MatchString(value) {
+			violations = append(violations, fmt.Sprintf("%s%s.value %q must match pattern %q", prefix, name, value, paramSchema.Pattern))
+		}
+	}
+	if len(violations) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "spec.parametersSchema validation failed: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// validateParameterSchemaType returns an error if the type is not one of the supported JSON types
+func validateParameterSchemaType(t wfv1.ParameterSchemaType) error {
+	switch t {
+	case "", wfv1.ParameterSchemaTypeString, wfv1.ParameterSchemaTypeInteger, wfv1.ParameterSchemaTypeNumber,
+		wfv1.ParameterSchemaTypeBoolean, wfv1.ParameterSchemaTypeArray, wfv1.ParameterSchemaTypeObject:
+		return nil
+	}
+	return fmt.Errorf("'%s' is not one of string, integer, number, boolean, array or object", t)
+}
+
+// checkParameterSchemaType returns a violation message if the value is not of the given JSON type
+func checkParameterSchemaType(t wfv1.ParameterSchemaType, value string) string {
+	switch t {
+	case wfv1.ParameterSchemaTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Sprintf("%q must be an integer", value)
+		}
+	case wfv1.ParameterSchemaTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Sprintf("%q must be a number", value)
+		}
+	case wfv1.ParameterSchemaTypeBoolean:
+		if value != "true" && value != "false" {
+			return fmt.Sprintf("%q must be a boolean", value)
+		}
+	case wfv1.ParameterSchemaTypeArray:
+		var v []interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return fmt.Sprintf("%q must be a JSON array", value)
+		}
+	case wfv1.ParameterSchemaTypeObject:
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return fmt.Sprintf("%q must be a JSON object", value)
+		}
+	}
+	return ""
+}
+
 func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tmplCtx *templateresolution.Context, tmpl *wfv1.Template) error {
 	err := validateNonLeaf(tmpl)
 	if err != nil {
@@ -763,11 +932,16 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return err
 			}
+			err = ctx.validateLifecycleHooks(fmt.Sprintf("templates.%s.steps[%d].%s.hooks", tmpl.Name, i, step.Name), step.Hooks, tmplCtx)
+			if err != nil {
+				return err
+			}
 			resolvedTmpl, err := ctx.validateTemplateHolder(&step, tmplCtx, &FakeArguments{})
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
-			if step.HasExitHook() {
+			// hooks may refer to the outputs of their step
+			if step.HasExitHook() || len(step.Hooks) > 0 {
 				ctx.addOutputsToScope(resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, false, false)
 			}
 			resolvedTemplates[step.Name] = resolvedTmpl
@@ -928,6 +1102,10 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		err = validateArtifactGC(fmt.Sprintf("templates.%s.%s.artifactGC", tmpl.Name, artRef), art.ArtifactGC)
+		if err != nil {
+			return err
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
@@ -1252,6 +1430,10 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		if err != nil {
 			return err
 		}
+		err = ctx.validateLifecycleHooks(fmt.Sprintf("templates.%s.tasks.%s.hooks", tmpl.Name, task.Name), task.Hooks, tmplCtx)
+		if err != nil {
+			return err
+		}
 		err = validateDAGTaskArgumentDependency(task.Arguments, ancestry)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
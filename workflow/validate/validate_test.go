@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -998,6 +999,78 @@ func TestExitHandler(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+var stepWithLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: lifecycle-hooks-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: pass
+        hooks:
+          %s:
+            template: pass
+  - name: pass
+    container:
+      image: alpine:latest
+`
+
+func TestLifecycleHooks(t *testing.T) {
+	for _, event := range []string{"exit", "running", "succeeded", "failed", "error"} {
+		_, err := validate(fmt.Sprintf(stepWithLifecycleHooks, event))
+		assert.NoError(t, err, event)
+	}
+	_, err := validate(fmt.Sprintf(stepWithLifecycleHooks, "pending"))
+	assert.EqualError(t, err, "templates.main.steps[0].a.hooks.pending is not a valid lifecycle event. One of: exit, running, succeeded, failed, error")
+}
+
+var lifecycleHooksWithOutputs = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: lifecycle-hooks-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: output
+        hooks:
+          succeeded:
+            template: %s
+            arguments:
+              parameters:
+              - name: message
+                value: "{{steps.a.outputs.parameters.message}}"
+  - name: output
+    container:
+      image: alpine:latest
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /tmp/message
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestLifecycleHooksWithOutputs(t *testing.T) {
+	_, err := validate(fmt.Sprintf(lifecycleHooksWithOutputs, "print"))
+	assert.NoError(t, err)
+	_, err = validate(fmt.Sprintf(lifecycleHooksWithOutputs, "missing"))
+	assert.EqualError(t, err, "templates.main.steps[0].a.hooks.succeeded template name 'missing' undefined")
+}
+
 var workflowWithPriority = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1734,6 +1807,81 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	assert.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
 }
 
+var invalidArtifactGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: artifact-gc-strategy-unknown-
+spec:
+  artifactGC:
+    strategy: Foo
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay]
+      args: ["hello world"]
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+        artifactGC:
+          strategy: OnWorkflowCompletion
+`
+
+// TestIncorrectArtifactGCStrategy verifies artifact gc strategies are correct.
+func TestIncorrectArtifactGCStrategy(t *testing.T) {
+	wf := unmarshalWf(invalidArtifactGC)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "artifactGC.strategy unknown strategy 'Foo'")
+
+	for _, strategy := range []wfv1.ArtifactGCStrategy{wfv1.ArtifactGCNever, wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion} {
+		wf.Spec.ArtifactGC.Strategy = strategy
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	}
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].ArtifactGC.Strategy = "Foo"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.outputs.artifacts.out.artifactGC.strategy unknown strategy 'Foo'")
+}
+
+var memoizedArtifactGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: artifact-gc-memoized-
+spec:
+  artifactGC:
+    strategy: OnWorkflowDeletion
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    memoize:
+      key: my-key
+      cache:
+        configMap:
+          name: my-cache
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+`
+
+func TestMemoizedArtifactGC(t *testing.T) {
+	wf := unmarshalWf(memoizedArtifactGC)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.outputs.artifacts.out.artifactGC cannot be used with memoize, as the memoized outputs would refer to deleted artifacts")
+
+	// the artifact opts out of the workflow's strategy
+	wf.Spec.Templates[0].Outputs.Artifacts[0].ArtifactGC = &wfv1.ArtifactGC{}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+}
+
 var invalidPodGC = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2824,3 +2972,89 @@ spec:
 		}
 	}
 }
+
+var parametersSchemaWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameters-schema-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: region
+        value: %s
+      - name: replicas
+        value: "%s"
+  parametersSchema:
+    required: [region, replicas]
+    properties:
+      region:
+        type: string
+        enum: [us, eu]
+      replicas:
+        type: integer
+        pattern: "^[1-9]$"
+  templates:
+    - name: main
+      container:
+        image: docker/whalesay:latest
+`
+
+func TestParametersSchema(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(fmt.Sprintf(parametersSchemaWorkflow, "us", "3"))
+		assert.NoError(t, err)
+	})
+	t.Run("Enum", func(t *testing.T) {
+		_, err := validate(fmt.Sprintf(parametersSchemaWorkflow, "asia", "3"))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `spec.arguments.region.value "asia" must be one of [us eu]`)
+		}
+	})
+	t.Run("TypeAndPattern", func(t *testing.T) {
+		_, err := validate(fmt.Sprintf(parametersSchemaWorkflow, "eu", "ten"))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `spec.arguments.replicas.value "ten" must be an integer`)
+			assert.Contains(t, err.Error(), `spec.arguments.replicas.value "ten" must match pattern "^[1-9]$"`)
+		}
+	})
+	t.Run("Required", func(t *testing.T) {
+		wf := unmarshalWf(fmt.Sprintf(parametersSchemaWorkflow, "us", "3"))
+		wf.Spec.Arguments.Parameters = wf.Spec.Arguments.Parameters[:1]
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "spec.arguments.replicas is required")
+		}
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{Lint: true})
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidType", func(t *testing.T) {
+		wf := unmarshalWf(fmt.Sprintf(parametersSchemaWorkflow, "us", "3"))
+		wf.Spec.ParametersSchema.Properties["region"] = wfv1.ParameterSchema{Type: "text"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "spec.parametersSchema.properties.region.type 'text' is not one of")
+		}
+	})
+	t.Run("InvalidTypeWithoutValue", func(t *testing.T) {
+		wf := unmarshalWf(fmt.Sprintf(parametersSchemaWorkflow, "us", "3"))
+		wf.Spec.ParametersSchema.Properties["zone"] = wfv1.ParameterSchema{Type: "text"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "spec.parametersSchema.properties.zone.type 'text' is not one of")
+		}
+	})
+	t.Run("AllViolations", func(t *testing.T) {
+		wf := unmarshalWf(fmt.Sprintf(parametersSchemaWorkflow, "us", "ten"))
+		wf.Spec.Arguments.Parameters = wf.Spec.Arguments.Parameters[1:]
+		wf.Spec.ParametersSchema.Properties["region"] = wfv1.ParameterSchema{Pattern: "["}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "spec.arguments.region is required")
+			assert.Contains(t, err.Error(), "spec.parametersSchema.properties.region.pattern is invalid")
+			assert.Contains(t, err.Error(), `spec.arguments.replicas.value "ten" must be an integer`)
+			assert.Contains(t, err.Error(), `spec.arguments.replicas.value "ten" must match pattern "^[1-9]$"`)
+		}
+	})
+}